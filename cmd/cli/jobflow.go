@@ -62,6 +62,13 @@ func buildJobFlowCmd() *cobra.Command {
 			},
 			InitFlags: jobflow.InitDeleteFlags,
 		},
+		"validate": {
+			Short: "validate the flows of a jobflow form a DAG",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, jobflow.ValidateJobFlow())
+			},
+			InitFlags: jobflow.InitValidateFlags,
+		},
 		"describe": {
 			Short: "describe a jobflow",
 			RunFunction: func(cmd *cobra.Command, args []string) {
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/cmd/cli/util"
+	"volcano.sh/volcano/pkg/cli/policy"
+)
+
+func buildPolicyCmd() *cobra.Command {
+	policyCmd := &cobra.Command{
+		Use:   "policy",
+		Short: "vcctl command line operation admission policy",
+	}
+
+	policyCommandMap := map[string]struct {
+		Short       string
+		RunFunction func(cmd *cobra.Command, args []string)
+		InitFlags   func(cmd *cobra.Command)
+	}{
+		"preflight": {
+			Short: "check the cluster is able to enforce admission policies",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.PreflightPolicies(cmd.Context()))
+			},
+			InitFlags: policy.InitPreflightFlags,
+		},
+		"scan": {
+			Short: "report the objects which would be denied by admission policies on their next update",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.ScanPolicies(cmd.Context()))
+			},
+			InitFlags: policy.InitScanFlags,
+		},
+		"impact": {
+			Short: "report the requests of a corpus newly denied or allowed by a change of admission policies",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.AnalyzePolicyImpact())
+			},
+			InitFlags: policy.InitImpactFlags,
+		},
+		"scrub-corpus": {
+			Short: "anonymize a recorded corpus of admission requests and remove its duplicates",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.ScrubCorpus())
+			},
+			InitFlags: policy.InitScrubFlags,
+		},
+		"drift": {
+			Short: "report installed admission policies and bindings which drifted from their source",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.DetectPolicyDrift(cmd.Context()))
+			},
+			InitFlags: policy.InitDriftFlags,
+		},
+		"decommission": {
+			Short: "remove the validating webhooks of resources whose validation is enforced by admission policies",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.DecommissionWebhooks(cmd.Context()))
+			},
+			InitFlags: policy.InitDecommissionFlags,
+		},
+		"serve": {
+			Short: "serve the verdicts of admission policies on objects over HTTP",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.ServePolicies(cmd.Context()))
+			},
+			InitFlags: policy.InitServeFlags,
+		},
+		"playground": {
+			Short: "serve a CEL playground evaluating validation expressions and their variables on objects",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, policy.ServePlayground(cmd.Context()))
+			},
+			InitFlags: policy.InitPlaygroundFlags,
+		},
+	}
+
+	for command, config := range policyCommandMap {
+		cmd := &cobra.Command{
+			Use:   command,
+			Short: config.Short,
+			Run:   config.RunFunction,
+		}
+		config.InitFlags(cmd)
+		policyCmd.AddCommand(cmd)
+	}
+
+	return policyCmd
+}
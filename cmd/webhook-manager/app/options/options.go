@@ -19,9 +19,11 @@ package options
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"volcano.sh/volcano/pkg/kube"
 )
@@ -33,6 +35,20 @@ const (
 	defaultEnabledAdmission     = "/jobs/mutate,/jobs/validate,/podgroups/mutate,/pods/validate,/pods/mutate,/queues/mutate,/queues/validate"
 	defaultHealthzAddress       = ":11251"
 	defaultGracefulShutdownTime = time.Second * 30
+	defaultAuditLogMaxSize      = 100
+	defaultAuditLogMaxBackups   = 10
+	defaultRecordRequestsMax    = 1000
+)
+
+const (
+	// ValidationBackendWebhook means validation of the resource is enforced by the webhook.
+	ValidationBackendWebhook = "webhook"
+	// ValidationBackendVAP means validation of the resource is enforced by ValidatingAdmissionPolicy,
+	// and the validating webhook admits every request of the resource.
+	ValidationBackendVAP = "vap"
+	// ValidationBackendBoth means validation of the resource is enforced by both the webhook and
+	// ValidatingAdmissionPolicy, which is used to shadow the webhook with policies during migration.
+	ValidationBackendBoth = "both"
 )
 
 // Config admission-controller server config.
@@ -54,6 +70,21 @@ type Config struct {
 	ConfigPath           string
 	EnabledAdmission     string
 	GracefulShutdownTime time.Duration
+	// ValidationBackends is the backend enforcing validation of each resource, keyed by resource
+	// name, e.g. jobs=vap. Resources not listed are validated by the webhook.
+	ValidationBackends map[string]string
+	// ShadowPolicyPath is the file or directory of ValidatingAdmissionPolicy manifests evaluated
+	// in shadow of the validating webhooks of the resources whose validation backend is both.
+	ShadowPolicyPath string
+	// AuditLogPath is the file admission decisions are written to, "-" means stdout.
+	// Admission decisions are not logged if it is empty.
+	AuditLogPath       string
+	AuditLogMaxSize    int
+	AuditLogMaxBackups int
+	// RecordRequestsDir is the directory the validated requests of Volcano resources are recorded to
+	// as AdmissionReview manifests, requests are not recorded if it is empty.
+	RecordRequestsDir string
+	RecordRequestsMax int
 
 	EnableHealthz bool
 	// HealthzBindAddress is the IP address and port for the health check server to serve on
@@ -91,6 +122,17 @@ func (c *Config) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.ConfigPath, "admission-conf", "", "The configmap file of this webhook")
 	fs.BoolVar(&c.EnableHealthz, "enable-healthz", false, "Enable the health check; it is false by default")
 	fs.StringVar(&c.HealthzBindAddress, "healthz-address", defaultHealthzAddress, "The address to listen on for the health check server.")
+	fs.StringToStringVar(&c.ValidationBackends, "validation-backends", nil, "The backend enforcing validation of each resource, e.g. jobs=vap,queues=both. "+
+		"Supported backends are webhook, vap and both; resources not listed are validated by the webhook. "+
+		"With both, the webhook enforces validation and the shadow policies are evaluated alongside it.")
+	fs.StringVar(&c.ShadowPolicyPath, "shadow-policies", "", "The file or directory of ValidatingAdmissionPolicy manifests evaluated in shadow of the validating webhooks "+
+		"of the resources whose validation backend is both, divergent verdicts are logged and counted in metrics.")
+	fs.StringVar(&c.AuditLogPath, "audit-log-path", "", "If set, all admission decisions are written to this file as JSON lines, '-' means standard out.")
+	fs.IntVar(&c.AuditLogMaxSize, "audit-log-maxsize", defaultAuditLogMaxSize, "The maximum size in megabytes of the audit log file before it gets rotated.")
+	fs.IntVar(&c.AuditLogMaxBackups, "audit-log-maxbackup", defaultAuditLogMaxBackups, "The maximum number of rotated audit log files to retain.")
+	fs.StringVar(&c.RecordRequestsDir, "record-requests-dir", "", "If set, the requests of Volcano resources served by validating webhooks, except the ones delegated to ValidatingAdmissionPolicy, are recorded to this directory "+
+		"as AdmissionReview manifests, with the user and managed fields removed.")
+	fs.IntVar(&c.RecordRequestsMax, "record-requests-max", defaultRecordRequestsMax, "The maximum number of requests recorded to the record directory.")
 	fs.DurationVar(&c.GracefulShutdownTime, "graceful-shutdown-time", defaultGracefulShutdownTime, "The duration to wait during graceful shutdown before forcing termination.")
 }
 
@@ -102,6 +144,35 @@ func (c *Config) CheckPortOrDie() error {
 	return nil
 }
 
+// CheckValidationBackends check all validation backends are supported and set for one of the
+// resources validated by a webhook, and that shadow policies are given if a resource uses both.
+func (c *Config) CheckValidationBackends(resources []string) error {
+	validated := sets.New(resources...)
+	for _, resource := range sets.List(sets.KeySet(c.ValidationBackends)) {
+		if !validated.Has(resource) {
+			return fmt.Errorf("unknown resource %q in validation backends, should be one of %s", resource, strings.Join(sets.List(validated), ", "))
+		}
+		switch backend := c.ValidationBackends[resource]; backend {
+		case ValidationBackendWebhook, ValidationBackendVAP:
+		case ValidationBackendBoth:
+			if c.ShadowPolicyPath == "" {
+				return fmt.Errorf("validation backend both for resource %s requires shadow policies", resource)
+			}
+		default:
+			return fmt.Errorf("unsupported validation backend %q for resource %s, should be one of webhook, vap and both", backend, resource)
+		}
+	}
+	return nil
+}
+
+// ValidationBackend returns the backend enforcing validation of the resource.
+func (c *Config) ValidationBackend(resource string) string {
+	if backend, found := c.ValidationBackends[resource]; found {
+		return backend
+	}
+	return ValidationBackendWebhook
+}
+
 // readCAFiles read data from ca file path
 func (c *Config) readCAFiles() error {
 	var err error
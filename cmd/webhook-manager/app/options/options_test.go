@@ -56,6 +56,9 @@ func TestAddFlags(t *testing.T) {
 		ConfigPath:           "",
 		EnabledAdmission:     defaultEnabledAdmission,
 		GracefulShutdownTime: defaultGracefulShutdownTime,
+		AuditLogMaxSize:      defaultAuditLogMaxSize,
+		AuditLogMaxBackups:   defaultAuditLogMaxBackups,
+		RecordRequestsMax:    defaultRecordRequestsMax,
 		EnableHealthz:        false,
 		HealthzBindAddress:   defaultHealthzAddress,
 	}
@@ -64,3 +67,48 @@ func TestAddFlags(t *testing.T) {
 		t.Errorf("Got different run options than expected.\nGot: %+v\nExpected: %+v\n", s, expected)
 	}
 }
+
+func TestValidationBackends(t *testing.T) {
+	fs := pflag.NewFlagSet("validationbackendstest", pflag.ExitOnError)
+	s := NewConfig()
+	s.AddFlags(fs)
+
+	args := []string{
+		"--validation-backends=jobs=vap,queues=both",
+		"--shadow-policies=/etc/volcano/policies",
+	}
+	fs.Parse(args)
+
+	resources := []string{"jobs", "jobflows", "pods", "queues"}
+	if err := s.CheckValidationBackends(resources); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	testCases := map[string]string{
+		"jobs":   ValidationBackendVAP,
+		"queues": ValidationBackendBoth,
+		"pods":   ValidationBackendWebhook,
+	}
+	for resource, expected := range testCases {
+		if backend := s.ValidationBackend(resource); backend != expected {
+			t.Errorf("expected backend %s for %s, got %s", expected, resource, backend)
+		}
+	}
+
+	s.ValidationBackends["pods"] = "unknown"
+	if err := s.CheckValidationBackends(resources); err == nil {
+		t.Errorf("expected error for unsupported validation backend")
+	}
+	delete(s.ValidationBackends, "pods")
+
+	s.ValidationBackends["job"] = ValidationBackendVAP
+	if err := s.CheckValidationBackends(resources); err == nil {
+		t.Errorf("expected error for unknown resource")
+	}
+	delete(s.ValidationBackends, "job")
+
+	s.ShadowPolicyPath = ""
+	if err := s.CheckValidationBackends(resources); err == nil {
+		t.Errorf("expected error for backend both without shadow policies")
+	}
+}
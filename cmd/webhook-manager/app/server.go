@@ -35,8 +35,11 @@ import (
 	"volcano.sh/volcano/pkg/kube"
 	"volcano.sh/volcano/pkg/signals"
 	commonutil "volcano.sh/volcano/pkg/util"
+	"volcano.sh/volcano/pkg/webhooks/audit"
 	wkconfig "volcano.sh/volcano/pkg/webhooks/config"
+	"volcano.sh/volcano/pkg/webhooks/corpus"
 	"volcano.sh/volcano/pkg/webhooks/router"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
 )
 
 // Run start the service of admission controller.
@@ -63,6 +66,26 @@ func Run(config *options.Config) error {
 		klog.V(2).Infof("loadAdmissionConf:%v", admissionConf.ResGroupsConfig)
 	}
 
+	handlerOptions := router.HandlerOptions{}
+	if config.ShadowPolicyPath != "" {
+		handlerOptions.ShadowEvaluator, err = shadow.NewEvaluator(config.ShadowPolicyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load shadow policies: %v", err)
+		}
+	}
+	if config.AuditLogPath != "" {
+		handlerOptions.AuditLogger, err = audit.NewLogger(config.AuditLogPath, config.AuditLogMaxSize, config.AuditLogMaxBackups)
+		if err != nil {
+			return fmt.Errorf("failed to create audit logger: %v", err)
+		}
+	}
+	if config.RecordRequestsDir != "" {
+		handlerOptions.CorpusRecorder, err = corpus.NewRecorder(config.RecordRequestsDir, config.RecordRequestsMax)
+		if err != nil {
+			return fmt.Errorf("failed to create corpus recorder: %v", err)
+		}
+	}
+
 	vClient := getVolcanoClient(restConfig)
 	kubeClient := getKubeClient(restConfig)
 	factory := informers.NewSharedInformerFactory(vClient, 0)
@@ -83,10 +106,11 @@ func Run(config *options.Config) error {
 		}
 
 		klog.V(3).Infof("Registered '%s' as webhook.", service.Path)
-		http.HandleFunc(service.Path, service.Handler)
+		http.HandleFunc(service.Path, router.AdmissionHandlerFor(config, service, handlerOptions))
 
 		klog.V(3).Infof("Add CaCert for webhook <%s>", service.Path)
-		if err = addCaCertForWebhook(kubeClient, service, config.CaCertData); err != nil {
+		delegated := service.ValidatingConfig != nil && config.ValidationBackend(service.Resource()) == options.ValidationBackendVAP
+		if err = addCaCertForWebhook(kubeClient, service, config.CaCertData, delegated); err != nil {
 			return fmt.Errorf("failed to add caCert for webhook %v", err)
 		}
 		return nil
@@ -96,6 +120,8 @@ func Run(config *options.Config) error {
 
 	klog.V(3).Infof("Successfully added caCert for all webhooks")
 
+	http.Handle("/metrics", commonutil.PromHandler())
+
 	webhookServeError := make(chan struct{})
 	ctx := signals.SetupSignalContext()
 
@@ -126,6 +152,9 @@ func Run(config *options.Config) error {
 	if config.ConfigPath != "" {
 		go wkconfig.WatchAdmissionConf(config.ConfigPath, ctx.Done())
 	}
+	if handlerOptions.CorpusRecorder != nil {
+		go handlerOptions.CorpusRecorder.Run(ctx.Done())
+	}
 
 	select {
 	case <-ctx.Done():
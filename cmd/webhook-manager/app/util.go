@@ -40,7 +40,10 @@ import (
 
 const volcanoAdmissionPrefix = "volcano-admission-service"
 
-func addCaCertForWebhook(kubeClient *kubernetes.Clientset, service *router.AdmissionService, caBundle []byte) error {
+// addCaCertForWebhook sets the CA bundle of the webhook configurations of the admission service. The
+// validating webhook configuration of a resource whose validation is delegated to ValidatingAdmissionPolicy
+// may have been decommissioned, so it is not waited for when delegated is true.
+func addCaCertForWebhook(kubeClient *kubernetes.Clientset, service *router.AdmissionService, caBundle []byte, delegated bool) error {
 	if service.MutatingConfig != nil {
 		// update MutatingWebhookConfigurations
 		var mutatingWebhookName = volcanoAdmissionPrefix + strings.ReplaceAll(service.Path, "/", "-")
@@ -78,10 +81,15 @@ func addCaCertForWebhook(kubeClient *kubernetes.Clientset, service *router.Admis
 		// update ValidatingWebhookConfigurations
 		var validatingWebhookName = volcanoAdmissionPrefix + strings.ReplaceAll(service.Path, "/", "-")
 		var validatingWebhook *v1.ValidatingWebhookConfiguration
+		var decommissioned bool
 		webhookChanged := false
 		if err := wait.PollUntilContextTimeout(context.Background(), time.Second, 5*time.Minute, true, func(_ context.Context) (done bool, err error) {
 			validatingWebhook, err = kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), validatingWebhookName, metav1.GetOptions{})
 			if err != nil {
+				if apierrors.IsNotFound(err) && delegated {
+					decommissioned = true
+					return true, nil
+				}
 				if apierrors.IsNotFound(err) {
 					klog.Errorln(err)
 					return false, nil
@@ -92,6 +100,10 @@ func addCaCertForWebhook(kubeClient *kubernetes.Clientset, service *router.Admis
 		}); err != nil {
 			return fmt.Errorf("failed to get validating webhook %v", err)
 		}
+		if decommissioned {
+			klog.V(3).Infof("Validating webhook %s is decommissioned, validation is delegated to ValidatingAdmissionPolicy", validatingWebhookName)
+			return nil
+		}
 
 		for index := 0; index < len(validatingWebhook.Webhooks); index++ {
 			if validatingWebhook.Webhooks[index].ClientConfig.CABundle == nil ||
@@ -42,6 +42,7 @@ import (
 	_ "volcano.sh/volcano/pkg/webhooks/admission/pods/validate"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/queues/mutate"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/queues/validate"
+	"volcano.sh/volcano/pkg/webhooks/router"
 )
 
 var logFlushFreq = pflag.Duration("log-flush-frequency", 5*time.Second, "Maximum number of seconds between log flushes")
@@ -67,6 +68,10 @@ func main() {
 		klog.Fatalf("Configured port is invalid: %v", err)
 	}
 
+	if err := config.CheckValidationBackends(router.ValidatedResources()); err != nil {
+		klog.Fatalf("Configured validation backends are invalid: %v", err)
+	}
+
 	if err := config.ParseCAFiles(nil); err != nil {
 		klog.Fatalf("Failed to parse CA file: %v", err)
 	}
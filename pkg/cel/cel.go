@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cel compiles and evaluates the CEL expressions of Volcano admission policies.
+//
+// The environment is the one the apiserver uses for ValidatingAdmissionPolicy: the Kubernetes
+// base libraries (lists, regex, quantity, url, ...) with object, oldObject, request, namespaceObject
+// and optionally params declared. No function outside of that environment is added, so that an
+// expression accepted here behaves the same once it is installed in a cluster.
+package cel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/types/known/structpb"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/admission"
+	plugincel "k8s.io/apiserver/pkg/admission/plugin/cel"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/cel/environment"
+)
+
+// Validation is a CEL expression which must evaluate to true for a request to be admitted.
+type Validation struct {
+	// Name identifies the validation, e.g. the policy it belongs to.
+	Name       string
+	Expression string
+	Message    string
+}
+
+// GetExpression returns the expression of the validation.
+func (v *Validation) GetExpression() string {
+	return v.Expression
+}
+
+// ReturnTypes returns the types the expression of the validation may evaluate to.
+func (v *Validation) ReturnTypes() []*celgo.Type {
+	return []*celgo.Type{celgo.BoolType}
+}
+
+// Variable is a named CEL expression, whose value is available to the other expressions as
+// variables.<name>.
+type Variable struct {
+	Name       string
+	Expression string
+}
+
+// GetExpression returns the expression of the variable.
+func (v *Variable) GetExpression() string {
+	return v.Expression
+}
+
+// GetName returns the name of the variable.
+func (v *Variable) GetName() string {
+	return v.Name
+}
+
+// ReturnTypes returns the types the expression of the variable may evaluate to.
+func (v *Variable) ReturnTypes() []*celgo.Type {
+	return []*celgo.Type{celgo.AnyType}
+}
+
+// Compiler compiles validations in the canonical Volcano CEL environment.
+type Compiler struct {
+	envSet   *environment.EnvSet
+	compiler plugincel.ConditionCompiler
+}
+
+// NewCompiler creates a compiler whose environment is compatible with the default
+// compatibility version of the apiserver.
+func NewCompiler() *Compiler {
+	return NewCompilerForVersion(environment.DefaultCompatibilityVersion())
+}
+
+// NewCompilerForVersion creates a compiler whose environment only has the libraries available
+// to new expressions at the given compatibility version.
+func NewCompilerForVersion(compatibilityVersion *version.Version) *Compiler {
+	envSet := environment.MustBaseEnvSet(compatibilityVersion, true)
+	return &Compiler{
+		envSet:   envSet,
+		compiler: plugincel.NewConditionCompiler(envSet),
+	}
+}
+
+// Compile compiles the validations, the params variable is declared if hasParams is true.
+// All compilation errors are returned together.
+func (c *Compiler) Compile(validations []Validation, hasParams bool) (*Program, error) {
+	accessors := make([]plugincel.ExpressionAccessor, len(validations))
+	for i := range validations {
+		accessors[i] = &validations[i]
+	}
+
+	evaluator := c.compiler.CompileCondition(accessors, plugincel.OptionalVariableDeclarations{
+		HasParams:  hasParams,
+		StrictCost: true,
+	}, environment.NewExpressions)
+	if errs := evaluator.CompilationErrors(); len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+
+	return &Program{
+		validations: validations,
+		evaluator:   evaluator,
+	}, nil
+}
+
+// CompileWithVariables compiles the validations, which may reference the variables as variables.<name>.
+// A variable may reference the variables declared before it. All compilation errors are returned together.
+func (c *Compiler) CompileWithVariables(validations []Validation, variables []Variable, hasParams bool) (*Program, error) {
+	options := plugincel.OptionalVariableDeclarations{HasParams: hasParams, StrictCost: true}
+	compiler, err := c.compileVariables(variables, options)
+	if err != nil {
+		return nil, err
+	}
+
+	accessors := make([]plugincel.ExpressionAccessor, len(validations))
+	for i := range validations {
+		accessors[i] = &validations[i]
+	}
+	evaluator := compiler.CompileCondition(accessors, options, environment.NewExpressions)
+	if errs := evaluator.CompilationErrors(); len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+
+	return &Program{
+		validations: validations,
+		evaluator:   evaluator,
+	}, nil
+}
+
+// compileVariables returns a compiler of expressions which may reference the variables.
+func (c *Compiler) compileVariables(variables []Variable, options plugincel.OptionalVariableDeclarations) (*plugincel.CompositedCompiler, error) {
+	compiler, err := plugincel.NewCompositedCompiler(c.envSet)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for i := range variables {
+		if result := compiler.CompileAndStoreVariable(&variables[i], options, environment.NewExpressions); result.Error != nil {
+			errs = append(errs, fmt.Errorf("variable %s: %v", variables[i].Name, result.Error))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return compiler, nil
+}
+
+// Program is a compiled set of validations.
+type Program struct {
+	validations []Validation
+	evaluator   plugincel.ConditionEvaluator
+}
+
+// Result is the result of evaluating a validation.
+type Result struct {
+	Validation *Validation
+	// Allowed is true if the validation evaluated to true.
+	Allowed bool
+	// Err is set if the validation could not be evaluated, e.g. it exceeded the cost budget.
+	Err error
+}
+
+// Evaluate evaluates the validations against the admission request, params may be nil.
+func (p *Program) Evaluate(ctx context.Context, request *admissionv1.AdmissionRequest, params runtime.Object) ([]Result, error) {
+	attr, err := versionedAttributes(request)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations, _, err := p.evaluator.ForInput(ctx, attr, request, plugincel.OptionalVariableBindings{
+		VersionedParams: params,
+	}, nil, celconfig.RuntimeCELCostBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(evaluations))
+	for i, evaluation := range evaluations {
+		results[i].Validation = &p.validations[i]
+		if evaluation.Error != nil {
+			results[i].Err = evaluation.Error
+			continue
+		}
+		allowed, ok := evaluation.EvalResult.Value().(bool)
+		if !ok {
+			results[i].Err = fmt.Errorf("validation %q evaluated to %v, expected bool", p.validations[i].Expression, evaluation.EvalResult)
+			continue
+		}
+		results[i].Allowed = allowed
+	}
+	return results, nil
+}
+
+// Trace is the result of evaluating a validation together with the variables it may reference.
+type Trace struct {
+	Result    Result
+	Variables []VariableResult
+	// Cost is the runtime cost of evaluating the validation and the variables, out of a budget of
+	// celconfig.RuntimeCELCostBudget.
+	Cost int64
+}
+
+// VariableResult is the result of evaluating a variable.
+type VariableResult struct {
+	Variable *Variable
+	// Value is the value of the variable, converted to JSON types when possible.
+	Value interface{}
+	Err   error
+}
+
+// Trace compiles the variables and the validation, and evaluates them against the admission
+// request. A variable is evaluated at most once, whether the validation references it or not.
+// Compilation errors are returned, evaluation errors are reported in the trace.
+func (c *Compiler) Trace(ctx context.Context, validation Validation, variables []Variable, request *admissionv1.AdmissionRequest) (*Trace, error) {
+	options := plugincel.OptionalVariableDeclarations{HasParams: false, StrictCost: true}
+	compiler, err := c.compileVariables(variables, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// every variable is read by an expression of its own, so that its value is part of the trace
+	accessors := make([]plugincel.ExpressionAccessor, 0, len(variables)+1)
+	for i := range variables {
+		accessors = append(accessors, &Variable{Name: variables[i].Name, Expression: "variables." + variables[i].Name})
+	}
+	accessors = append(accessors, &validation)
+	evaluator := compiler.CompileCondition(accessors, options, environment.NewExpressions)
+	if errs := evaluator.CompilationErrors(); len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+
+	attr, err := versionedAttributes(request)
+	if err != nil {
+		return nil, err
+	}
+	evaluations, remaining, err := evaluator.ForInput(ctx, attr, request, plugincel.OptionalVariableBindings{}, nil, celconfig.RuntimeCELCostBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := &Trace{
+		Result:    Result{Validation: &validation},
+		Variables: make([]VariableResult, len(variables)),
+		Cost:      celconfig.RuntimeCELCostBudget - remaining,
+	}
+	for i, evaluation := range evaluations[:len(variables)] {
+		trace.Variables[i].Variable = &variables[i]
+		if evaluation.Error != nil {
+			// the error is the one of the variable, not of the expression reading it
+			prefix := fmt.Sprintf("expression '%s' resulted in error: ", accessors[i].GetExpression())
+			trace.Variables[i].Err = errors.New(strings.TrimPrefix(evaluation.Error.Error(), prefix))
+			continue
+		}
+		trace.Variables[i].Value = nativeValue(evaluation.EvalResult)
+	}
+
+	evaluation := evaluations[len(variables)]
+	if evaluation.Error != nil {
+		trace.Result.Err = evaluation.Error
+		return trace, nil
+	}
+	allowed, ok := evaluation.EvalResult.Value().(bool)
+	if !ok {
+		trace.Result.Err = fmt.Errorf("validation %q evaluated to %v, expected bool", validation.Expression, evaluation.EvalResult)
+		return trace, nil
+	}
+	trace.Result.Allowed = allowed
+	return trace, nil
+}
+
+// nativeValue converts the CEL value to JSON types, or to its string representation if it has none.
+func nativeValue(value ref.Val) interface{} {
+	native, err := value.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return fmt.Sprint(value.Value())
+	}
+	return native.(*structpb.Value).AsInterface()
+}
+
+func versionedAttributes(request *admissionv1.AdmissionRequest) (*admission.VersionedAttributes, error) {
+	object, err := decodeObject(request.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode object: %v", err)
+	}
+	oldObject, err := decodeObject(request.OldObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode oldObject: %v", err)
+	}
+
+	kind := schema.GroupVersionKind{Group: request.Kind.Group, Version: request.Kind.Version, Kind: request.Kind.Kind}
+	resource := schema.GroupVersionResource{Group: request.Resource.Group, Version: request.Resource.Version, Resource: request.Resource.Resource}
+	userInfo := &user.DefaultInfo{
+		Name:   request.UserInfo.Username,
+		UID:    request.UserInfo.UID,
+		Groups: request.UserInfo.Groups,
+	}
+	dryRun := request.DryRun != nil && *request.DryRun
+
+	attr := admission.NewAttributesRecord(object, oldObject, kind, request.Namespace, request.Name, resource,
+		request.SubResource, admission.Operation(request.Operation), nil, dryRun, userInfo)
+	return &admission.VersionedAttributes{
+		Attributes:         attr,
+		VersionedKind:      kind,
+		VersionedObject:    object,
+		VersionedOldObject: oldObject,
+	}, nil
+}
+
+func decodeObject(raw runtime.RawExtension) (runtime.Object, error) {
+	if raw.Object != nil {
+		return raw.Object, nil
+	}
+	if len(raw.Raw) == 0 {
+		return nil, nil
+	}
+
+	object := &unstructured.Unstructured{}
+	if err := object.UnmarshalJSON(raw.Raw); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
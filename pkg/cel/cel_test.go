@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+)
+
+func TestCompile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Validations []Validation
+		HasParams   bool
+		ExpectErr   bool
+	}{
+		{
+			Name: "valid expressions",
+			Validations: []Validation{
+				{Expression: "object.spec.minAvailable >= 0"},
+				{Expression: "object.spec.tasks.all(t, t.name.size() <= 63)"},
+			},
+		},
+		{
+			Name: "kubernetes quantity library is available",
+			Validations: []Validation{
+				{Expression: "quantity('1Gi').isGreaterThan(quantity('1Mi'))"},
+			},
+		},
+		{
+			Name: "syntax error",
+			Validations: []Validation{
+				{Expression: "object.spec.minAvailable >="},
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "non bool expression",
+			Validations: []Validation{
+				{Expression: "object.spec.minAvailable"},
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "params without declaration",
+			Validations: []Validation{
+				{Expression: "object.spec.minAvailable <= params.maxMinAvailable"},
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "params with declaration",
+			Validations: []Validation{
+				{Expression: "object.spec.minAvailable <= params.maxMinAvailable"},
+			},
+			HasParams: true,
+		},
+	}
+
+	compiler := NewCompiler()
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := compiler.Compile(testCase.Validations, testCase.HasParams)
+			if (err != nil) != testCase.ExpectErr {
+				t.Errorf("expected error %v, got %v", testCase.ExpectErr, err)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	job := &batchv1alpha1.Job{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch.volcano.sh/v1alpha1",
+			Kind:       "Job",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job",
+			Namespace: "default",
+		},
+		Spec: batchv1alpha1.JobSpec{
+			MinAvailable: 3,
+			Tasks: []batchv1alpha1.TaskSpec{
+				{Name: "ps", Replicas: 1},
+				{Name: "worker", Replicas: 1},
+			},
+		},
+	}
+	raw, err := json.Marshal(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: "batch.volcano.sh", Version: "v1alpha1", Kind: "Job"},
+		Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs"},
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+	params := &unstructured.Unstructured{Object: map[string]interface{}{"maxMinAvailable": int64(2)}}
+
+	testCases := []struct {
+		Name          string
+		Validation    Validation
+		Params        runtime.Object
+		ExpectAllowed bool
+		ExpectErr     bool
+	}{
+		{
+			Name:          "minAvailable is not greater than total replicas",
+			Validation:    Validation{Expression: "object.spec.minAvailable <= object.spec.tasks.map(t, t.replicas).sum()"},
+			ExpectAllowed: false,
+		},
+		{
+			Name:          "task names are unique",
+			Validation:    Validation{Expression: "object.spec.tasks.all(t, object.spec.tasks.exists_one(o, o.name == t.name))"},
+			ExpectAllowed: true,
+		},
+		{
+			Name:          "request operation",
+			Validation:    Validation{Expression: "request.operation == 'CREATE' && !has(oldObject.spec)"},
+			ExpectAllowed: true,
+		},
+		{
+			Name:          "params are bound",
+			Validation:    Validation{Expression: "object.spec.minAvailable <= params.maxMinAvailable"},
+			Params:        params,
+			ExpectAllowed: false,
+		},
+		{
+			Name:       "evaluation error",
+			Validation: Validation{Expression: "object.spec.minAvailable / 0 == 0"},
+			ExpectErr:  true,
+		},
+	}
+
+	compiler := NewCompiler()
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			program, err := compiler.Compile([]Validation{testCase.Validation}, true)
+			if err != nil {
+				t.Fatalf("failed to compile: %v", err)
+			}
+			results, err := program.Evaluate(context.TODO(), request, testCase.Params)
+			if err != nil {
+				t.Fatalf("failed to evaluate: %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(results))
+			}
+			if (results[0].Err != nil) != testCase.ExpectErr {
+				t.Errorf("expected error %v, got %v", testCase.ExpectErr, results[0].Err)
+			}
+			if results[0].Allowed != testCase.ExpectAllowed {
+				t.Errorf("expected allowed %v, got %v", testCase.ExpectAllowed, results[0].Allowed)
+			}
+		})
+	}
+}
+
+func TestTrace(t *testing.T) {
+	request := &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: "batch.volcano.sh", Version: "v1alpha1", Kind: "Job"},
+		Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs"},
+		Name:      "job",
+		Namespace: "default",
+		Operation: admissionv1.Create,
+		Object: runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"batch.volcano.sh/v1alpha1","kind":"Job","metadata":{"name":"job"},"spec":{"minAvailable":3,"tasks":[{"name":"ps","replicas":1},{"name":"worker","replicas":1}]}}`),
+		},
+	}
+	variables := []Variable{
+		{Name: "replicas", Expression: "object.spec.tasks.map(t, t.replicas).sum()"},
+		{Name: "names", Expression: "object.spec.tasks.map(t, t.name)"},
+		{Name: "queue", Expression: "object.spec.queue"},
+	}
+
+	compiler := NewCompiler()
+	trace, err := compiler.Trace(context.TODO(), Validation{Expression: "object.spec.minAvailable <= variables.replicas"}, variables, request)
+	if err != nil {
+		t.Fatalf("failed to trace: %v", err)
+	}
+	if trace.Result.Allowed || trace.Result.Err != nil {
+		t.Errorf("expected denial, got allowed %v, error %v", trace.Result.Allowed, trace.Result.Err)
+	}
+	if trace.Cost <= 0 {
+		t.Errorf("expected positive cost, got %d", trace.Cost)
+	}
+	if value := trace.Variables[0].Value; value != float64(2) {
+		t.Errorf("expected replicas 2, got %v", value)
+	}
+	if value := trace.Variables[1].Value; !reflect.DeepEqual(value, []interface{}{"ps", "worker"}) {
+		t.Errorf("expected names [ps worker], got %v", value)
+	}
+	if trace.Variables[2].Err == nil {
+		t.Errorf("expected error evaluating the queue, got %v", trace.Variables[2].Value)
+	}
+
+	if _, err := compiler.Trace(context.TODO(), Validation{Expression: "true"}, []Variable{{Name: "broken", Expression: "object.spec.minAvailable >="}}, request); err == nil {
+		t.Errorf("expected error for variable with invalid expression")
+	}
+	if _, err := compiler.Trace(context.TODO(), Validation{Expression: "variables.missing"}, variables, request); err == nil {
+		t.Errorf("expected error for undeclared variable")
+	}
+}
+
+func TestCompileWithVariables(t *testing.T) {
+	request := &admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: []byte(`{"apiVersion":"batch.volcano.sh/v1alpha1","kind":"Job","spec":{"minAvailable":3,"tasks":[{"replicas":1},{"replicas":1}]}}`)},
+	}
+	variables := []Variable{
+		{Name: "replicas", Expression: "object.spec.tasks.map(t, t.replicas).sum()"},
+		{Name: "enough", Expression: "object.spec.minAvailable <= variables.replicas"},
+	}
+
+	compiler := NewCompiler()
+	program, err := compiler.CompileWithVariables([]Validation{{Expression: "variables.enough"}}, variables, false)
+	if err != nil {
+		t.Fatalf("failed to compile: %v", err)
+	}
+	results, err := program.Evaluate(context.TODO(), request, nil)
+	if err != nil {
+		t.Fatalf("failed to evaluate: %v", err)
+	}
+	if len(results) != 1 || results[0].Err != nil || results[0].Allowed {
+		t.Errorf("expected denial, got %+v", results)
+	}
+
+	if _, err := compiler.CompileWithVariables([]Validation{{Expression: "variables.missing"}}, variables, false); err == nil {
+		t.Errorf("expected error for undeclared variable")
+	}
+}
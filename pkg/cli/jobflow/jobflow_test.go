@@ -19,6 +19,7 @@ package jobflow
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/spf13/cobra"
 	"io"
 	"net/http"
@@ -275,6 +276,65 @@ Created JobFlow: default/test-b`,
 	}
 }
 
+func TestValidateJobFlow(t *testing.T) {
+	invalidContent := `apiVersion: flow.volcano.sh/v1alpha1
+kind: JobFlow
+metadata:
+  name: test-c
+spec:
+  flows:
+    - name: a
+      dependsOn:
+        targets: ['b']
+    - name: b
+      dependsOn:
+        targets: ['a', 'x']
+`
+	testCases := []struct {
+		name           string
+		Content        string
+		ExpectedErr    error
+		ExpectedOutput string
+	}{
+		{
+			name:        "Valid Case",
+			Content:     content,
+			ExpectedErr: nil,
+			ExpectedOutput: `JobFlow default/test-a is valid
+JobFlow default/test-b is valid`,
+		},
+		{
+			name:        "Invalid Case",
+			Content:     invalidContent,
+			ExpectedErr: fmt.Errorf("1 invalid JobFlow(s) found in test.yaml"),
+			ExpectedOutput: `JobFlow default/test-c is invalid:
+  - vertex is not defined: x, depended on by flow b
+  - dependency cycle: a -> b -> a`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			validateJobFlowFlags.FilePath = "test.yaml"
+			err := createAndWriteFile(validateJobFlowFlags.FilePath, testCase.Content)
+			if err != nil {
+				t.Fatalf("Failed to create and write file: %v", err)
+			}
+			defer os.Remove(validateJobFlowFlags.FilePath)
+
+			r, oldStdout := redirectStdout()
+			defer r.Close()
+			err = ValidateJobFlow()
+			gotOutput := captureOutput(r, oldStdout)
+			if !reflect.DeepEqual(err, testCase.ExpectedErr) {
+				t.Fatalf("test case: %s failed: got: %v, want: %v", testCase.name, err, testCase.ExpectedErr)
+			}
+			if gotOutput != testCase.ExpectedOutput {
+				t.Fatalf("test case: %s failed: got: %s, want: %s", testCase.name, gotOutput, testCase.ExpectedOutput)
+			}
+		})
+	}
+}
+
 func TestDescribeJobFlow(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -420,6 +480,15 @@ func TestInitCreateFlags(t *testing.T) {
 	}
 }
 
+func TestInitValidateFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitValidateFlags(&cmd)
+
+	if cmd.Flag("file") == nil {
+		t.Errorf("Could not find the flag file")
+	}
+}
+
 func TestInitGetFlags(t *testing.T) {
 	var cmd cobra.Command
 	InitGetFlags(&cmd)
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+	"volcano.sh/volcano/pkg/webhooks/admission/jobflows/dag"
+)
+
+type validateFlags struct {
+	// FilePath is the file path of jobflow
+	FilePath string
+}
+
+var validateJobFlowFlags = &validateFlags{}
+
+// InitValidateFlags is used to init all flags during jobflow validating.
+func InitValidateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&validateJobFlowFlags.FilePath, "file", "f", "", "the path to the YAML file containing the jobflow")
+}
+
+// ValidateJobFlow checks the flows of the jobflows in a file form a DAG, without a cluster.
+func ValidateJobFlow() error {
+	yamlData, err := os.ReadFile(validateJobFlowFlags.FilePath)
+	if err != nil {
+		return err
+	}
+
+	invalidCount := 0
+	for _, doc := range strings.Split(string(yamlData), "---") {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+
+		obj := &flowv1alpha1.JobFlow{}
+		if err = yaml.Unmarshal([]byte(doc), obj); err != nil {
+			return err
+		}
+		if obj.Namespace == "" {
+			obj.Namespace = "default"
+		}
+
+		errs := dag.Validate(obj.Spec.Flows)
+		if len(errs) == 0 {
+			fmt.Printf("JobFlow %s/%s is valid\n", obj.Namespace, obj.Name)
+			continue
+		}
+		invalidCount++
+		fmt.Printf("JobFlow %s/%s is invalid:\n", obj.Namespace, obj.Name)
+		for _, err := range errs {
+			fmt.Printf("  - %v\n", err)
+		}
+	}
+
+	if invalidCount > 0 {
+		return fmt.Errorf("%d invalid JobFlow(s) found in %s", invalidCount, validateJobFlowFlags.FilePath)
+	}
+	return nil
+}
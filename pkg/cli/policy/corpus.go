@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/pkg/webhooks/corpus"
+)
+
+type scrubFlags struct {
+	// CorpusPath is the file or directory of the recorded AdmissionReviews
+	CorpusPath string
+	// OutputDir is the directory the scrubbed AdmissionReviews are written to
+	OutputDir string
+	// Salt is the salt of the hashed names
+	Salt string
+	// Minimize keeps only the structurally distinct AdmissionReviews
+	Minimize bool
+}
+
+var scrubCorpusFlags = &scrubFlags{}
+
+// InitScrubFlags is used to init all flags during corpus scrubbing.
+func InitScrubFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&scrubCorpusFlags.CorpusPath, "corpus", "", "", "the file or directory of the recorded AdmissionReviews")
+	cmd.Flags().StringVarP(&scrubCorpusFlags.OutputDir, "output", "o", "", "the directory the scrubbed AdmissionReviews are written to")
+	cmd.Flags().StringVarP(&scrubCorpusFlags.Salt, "salt", "", "", "the salt of the hashed names and label values, a random one is used if empty")
+	cmd.Flags().BoolVarP(&scrubCorpusFlags.Minimize, "minimize", "", true, "only keep the first of the AdmissionReviews with the same structure and verdict")
+}
+
+// ScrubCorpus anonymizes a recorded corpus and removes its structural duplicates, so that it can
+// be committed as test data.
+func ScrubCorpus() error {
+	if scrubCorpusFlags.CorpusPath == "" || scrubCorpusFlags.OutputDir == "" {
+		return fmt.Errorf("corpus and output must be specified")
+	}
+	if filepath.Clean(scrubCorpusFlags.CorpusPath) == filepath.Clean(scrubCorpusFlags.OutputDir) {
+		return fmt.Errorf("output must differ from corpus")
+	}
+
+	salt := scrubCorpusFlags.Salt
+	if salt == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return err
+		}
+		salt = hex.EncodeToString(b)
+	}
+
+	reviews, err := corpus.Load(scrubCorpusFlags.CorpusPath)
+	if err != nil {
+		return err
+	}
+	anonymizer := corpus.NewAnonymizer(salt)
+	for _, review := range reviews {
+		if err := anonymizer.Anonymize(review); err != nil {
+			return fmt.Errorf("failed to anonymize request %s: %v", review.Request.UID, err)
+		}
+	}
+	scrubbed := reviews
+	if scrubCorpusFlags.Minimize {
+		if scrubbed, err = corpus.Minimize(reviews); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(scrubCorpusFlags.OutputDir, 0755); err != nil {
+		return err
+	}
+	for _, review := range scrubbed {
+		if err := corpus.Write(scrubCorpusFlags.OutputDir, review); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Wrote %d of %d request(s) to %s\n", len(scrubbed), len(reviews), scrubCorpusFlags.OutputDir)
+	return nil
+}
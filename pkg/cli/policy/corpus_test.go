@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+const recorded = `
+apiVersion: admission.k8s.io/v1
+kind: AdmissionReview
+request:
+  uid: "1"
+  resource: {group: batch.volcano.sh, version: v1alpha1, resource: jobs}
+  name: trainer
+  namespace: team-a
+  operation: CREATE
+  object:
+    metadata: {name: trainer, namespace: team-a}
+    spec: {minAvailable: 1}
+response:
+  allowed: true
+---
+apiVersion: admission.k8s.io/v1
+kind: AdmissionReview
+request:
+  uid: "2"
+  resource: {group: batch.volcano.sh, version: v1alpha1, resource: jobs}
+  name: evaluator
+  namespace: team-b
+  operation: CREATE
+  object:
+    metadata: {name: evaluator, namespace: team-b}
+    spec: {minAvailable: 2}
+response:
+  allowed: true
+`
+
+func TestInitScrubFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitScrubFlags(&cmd)
+
+	for _, flag := range []string{"corpus", "output", "salt", "minimize"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestScrubCorpus(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "recorded.yaml"), []byte(recorded), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Minimize      bool
+		ExpectedFiles int
+	}{
+		{Name: "minimized", Minimize: true, ExpectedFiles: 1},
+		{Name: "not minimized", Minimize: false, ExpectedFiles: 2},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "scrubbed")
+			scrubCorpusFlags.CorpusPath = filepath.Join(dir, "recorded.yaml")
+			scrubCorpusFlags.OutputDir = output
+			scrubCorpusFlags.Minimize = testCase.Minimize
+			if err := ScrubCorpus(); err != nil {
+				t.Fatalf("failed to scrub corpus: %v", err)
+			}
+
+			files, err := filepath.Glob(filepath.Join(output, "*.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != testCase.ExpectedFiles {
+				t.Errorf("expected %d files, got %v", testCase.ExpectedFiles, files)
+			}
+			// the scrubbed corpus can be replayed
+			requests, err := loadCorpus(output)
+			if err != nil {
+				t.Fatalf("failed to load scrubbed corpus: %v", err)
+			}
+			for _, request := range requests {
+				if request.Name == "trainer" || request.Name == "evaluator" {
+					t.Errorf("expected names to be anonymized, got %s", request.Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/volcano/cmd/webhook-manager/app/options"
+	"volcano.sh/volcano/pkg/cli/util"
+)
+
+const (
+	// webhookConfigurationPrefix is the name prefix of the webhook configurations registered by volcano-admission.
+	webhookConfigurationPrefix = "volcano-admission-service-"
+	// validationBackendsFlag is the flag of volcano-admission setting the validation backend of each resource.
+	validationBackendsFlag = "--validation-backends"
+)
+
+type decommissionFlags struct {
+	util.CommonFlags
+	// Namespace is the namespace volcano is installed in
+	Namespace string
+	// Release is the helm release name of volcano
+	Release string
+	// SecretName is the name of the secret holding the admission certificates
+	SecretName string
+	// DryRun only prints the actions without performing them
+	DryRun bool
+	// Force removes the webhooks of delegated resources without an enforcing policy
+	Force bool
+}
+
+var decommissionWebhookFlags = &decommissionFlags{}
+
+// InitDecommissionFlags is used to init all flags during webhook decommissioning.
+func InitDecommissionFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &decommissionWebhookFlags.CommonFlags)
+	cmd.Flags().StringVarP(&decommissionWebhookFlags.Namespace, "namespace", "n", "volcano-system", "the namespace volcano is installed in")
+	cmd.Flags().StringVarP(&decommissionWebhookFlags.Release, "release", "", "volcano", "the helm release name of volcano")
+	cmd.Flags().StringVarP(&decommissionWebhookFlags.SecretName, "secret", "", "volcano-admission-secret", "the name of the secret holding the admission certificates")
+	cmd.Flags().BoolVarP(&decommissionWebhookFlags.DryRun, "dry-run", "", false, "only print what would be removed")
+	cmd.Flags().BoolVarP(&decommissionWebhookFlags.Force, "force", "", false,
+		"remove the validating webhooks of the resources delegated to ValidatingAdmissionPolicy, even if no healthy policy and binding enforce their validation")
+}
+
+// action is a step of the decommissioning.
+type action struct {
+	description string
+	run         func(ctx context.Context) error
+}
+
+// DecommissionWebhooks removes the validating webhook configurations of the resources whose validation
+// backend is vap, once an installed ValidatingAdmissionPolicy and its binding enforce their validation.
+// The deployment of volcano-admission is scaled down and its service and certificates are removed once
+// no webhook configuration routes to it anymore.
+//
+// volcano-admission keeps serving the webhooks of the delegated resources and starts without their
+// configurations. A helm upgrade re-creates the configurations from the chart, which is harmless as the
+// webhooks of delegated resources admit every request, and they are removed again by a new decommissioning.
+func DecommissionWebhooks(ctx context.Context) error {
+	config, err := util.BuildConfig(decommissionWebhookFlags.Master, decommissionWebhookFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	actions, remaining, err := planDecommission(ctx, client, decommissionWebhookFlags)
+	if err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		fmt.Printf("Nothing to decommission\n")
+	}
+
+	for _, a := range actions {
+		if decommissionWebhookFlags.DryRun {
+			fmt.Printf("%s (dry run)\n", a.description)
+			continue
+		}
+		if err := a.run(ctx); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to %s: %v", a.description, err)
+		}
+		fmt.Printf("%s\n", a.description)
+	}
+	if len(actions) > 0 {
+		fmt.Printf("A helm upgrade of release %s re-creates the removed webhook configurations, decommission them again after upgrading\n",
+			decommissionWebhookFlags.Release)
+	}
+	if len(remaining) > 0 {
+		fmt.Printf("Keeping deployment %s/%s-admission, its service and certificates, still used by: %s\n",
+			decommissionWebhookFlags.Namespace, decommissionWebhookFlags.Release, strings.Join(remaining, ", "))
+	}
+	return nil
+}
+
+// planDecommission returns the actions decommissioning the webhooks, ordered so that the apiserver
+// stops calling the webhooks before their backend goes away, and the webhook configurations which
+// still route to the backend. Resources which do not exist are skipped.
+//
+// The validation backends are read from the arguments of the admission deployment. The validating
+// webhook configuration of a resource whose backend is vap is only removed if a healthy policy bound
+// with the Deny action matches the resource, unless flags.Force is set.
+func planDecommission(ctx context.Context, client kubernetes.Interface, flags *decommissionFlags) ([]action, []string, error) {
+	namespace := flags.Namespace
+	deploymentName := flags.Release + "-admission"
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the validation backends from deployment %s/%s: %v", namespace, deploymentName, err)
+	}
+	backends := validationBackends(deployment)
+
+	enforced, err := enforcedResources(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var actions []action
+	var unenforced []string
+	delegated := sets.New[string]()
+	for _, resource := range sets.List(sets.KeySet(backends)) {
+		if backends[resource] != options.ValidationBackendVAP {
+			continue
+		}
+		if !enforced.Has(resource) && !enforced.Has("*") && !flags.Force {
+			unenforced = append(unenforced, resource)
+			continue
+		}
+		delegated.Insert(webhookConfigurationPrefix + resource + "-validate")
+	}
+	if len(unenforced) > 0 {
+		return nil, nil, fmt.Errorf("no healthy ValidatingAdmissionPolicy bound with the Deny action enforces the validation of %s, "+
+			"install one or use --force to remove their webhooks anyway", strings.Join(unenforced, ", "))
+	}
+
+	var remaining []string
+	validatingConfigs, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range validatingConfigs.Items {
+		if !strings.HasPrefix(c.Name, webhookConfigurationPrefix) {
+			continue
+		}
+		if !delegated.Has(c.Name) {
+			remaining = append(remaining, c.Name)
+			continue
+		}
+		name := c.Name
+		actions = append(actions, action{
+			description: fmt.Sprintf("delete validatingwebhookconfiguration %s", name),
+			run: func(ctx context.Context) error {
+				return client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+			},
+		})
+	}
+
+	// there is no MutatingAdmissionPolicy replacing the mutating webhooks yet, they are always kept
+	mutatingConfigs, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range mutatingConfigs.Items {
+		if strings.HasPrefix(c.Name, webhookConfigurationPrefix) {
+			remaining = append(remaining, c.Name)
+		}
+	}
+	if len(remaining) > 0 {
+		return actions, remaining, nil
+	}
+
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+		actions = append(actions, action{
+			description: fmt.Sprintf("scale deployment %s/%s to 0 replicas", namespace, deploymentName),
+			run: func(ctx context.Context) error {
+				patch := []byte(`{"spec":{"replicas":0}}`)
+				_, err := client.AppsV1().Deployments(namespace).Patch(ctx, deploymentName, types.MergePatchType, patch, metav1.PatchOptions{})
+				return err
+			},
+		})
+	}
+
+	serviceName := flags.Release + "-admission-service"
+	if _, err := client.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{}); err == nil {
+		actions = append(actions, action{
+			description: fmt.Sprintf("delete service %s/%s", namespace, serviceName),
+			run: func(ctx context.Context) error {
+				return client.CoreV1().Services(namespace).Delete(ctx, serviceName, metav1.DeleteOptions{})
+			},
+		})
+	} else if !apierrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	secretName := flags.SecretName
+	if _, err := client.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{}); err == nil {
+		actions = append(actions, action{
+			description: fmt.Sprintf("delete secret %s/%s", namespace, secretName),
+			run: func(ctx context.Context) error {
+				return client.CoreV1().Secrets(namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
+			},
+		})
+	} else if !apierrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	return actions, nil, nil
+}
+
+// validationBackends returns the validation backends set by the --validation-backends argument of the
+// containers of the admission deployment.
+func validationBackends(deployment *appsv1.Deployment) map[string]string {
+	backends := map[string]string{}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		args := append(append([]string{}, container.Command...), container.Args...)
+		for i, arg := range args {
+			var value string
+			switch {
+			case strings.HasPrefix(arg, validationBackendsFlag+"="):
+				value = strings.TrimPrefix(arg, validationBackendsFlag+"=")
+			case arg == validationBackendsFlag && i+1 < len(args):
+				value = args[i+1]
+			default:
+				continue
+			}
+			for _, pair := range strings.Split(value, ",") {
+				if resource, backend, found := strings.Cut(pair, "="); found {
+					backends[strings.TrimSpace(resource)] = strings.TrimSpace(backend)
+				}
+			}
+		}
+	}
+	return backends
+}
+
+// enforcedResources returns the resources matched by a healthy ValidatingAdmissionPolicy, which is bound
+// with the Deny action. A policy is healthy once the apiserver observed its latest generation and found
+// no type checking warning.
+func enforcedResources(ctx context.Context, client kubernetes.Interface) (sets.Set[string], error) {
+	bindings, err := client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	denying := sets.New[string]()
+	for _, b := range bindings.Items {
+		for _, a := range b.Spec.ValidationActions {
+			if a == admissionregistrationv1.Deny {
+				denying.Insert(b.Spec.PolicyName)
+			}
+		}
+	}
+
+	policies, err := client.AdmissionregistrationV1().ValidatingAdmissionPolicies().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	enforced := sets.New[string]()
+	for _, p := range policies.Items {
+		if !denying.Has(p.Name) || p.Spec.MatchConstraints == nil || p.Status.ObservedGeneration != p.Generation {
+			continue
+		}
+		if p.Status.TypeChecking != nil && len(p.Status.TypeChecking.ExpressionWarnings) > 0 {
+			continue
+		}
+		for _, rule := range p.Spec.MatchConstraints.ResourceRules {
+			if !matchesVolcanoGroup(rule.APIGroups) {
+				continue
+			}
+			enforced.Insert(rule.Resources...)
+		}
+	}
+	return enforced, nil
+}
+
+func matchesVolcanoGroup(groups []string) bool {
+	for _, group := range groups {
+		if group == "*" || strings.HasSuffix(group, "volcano.sh") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestInitDecommissionFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitDecommissionFlags(&cmd)
+
+	for _, flag := range []string{"master", "kubeconfig", "namespace", "release", "secret", "dry-run", "force"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func admissionDeployment(backends string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission", Namespace: "volcano-system"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name: "admission",
+						Args: []string{"--enabled-admission=/jobs/mutate,/jobs/validate", "--validation-backends=" + backends},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func enforcingPolicy(name string, resources ...string) []runtime.Object {
+	return []runtime.Object{
+		&admissionregistrationv1.ValidatingAdmissionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+				MatchConstraints: &admissionregistrationv1.MatchResources{
+					ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{{
+						RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+							Rule: admissionregistrationv1.Rule{APIGroups: []string{"batch.volcano.sh", "scheduling.volcano.sh"}, Resources: resources},
+						},
+					}},
+				},
+			},
+			Status: admissionregistrationv1.ValidatingAdmissionPolicyStatus{ObservedGeneration: 1},
+		},
+		&admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+				PolicyName:        name,
+				ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+			},
+		},
+	}
+}
+
+func descriptions(actions []action) []string {
+	var descriptions []string
+	for _, a := range actions {
+		descriptions = append(descriptions, a.description)
+	}
+	return descriptions
+}
+
+func TestPlanDecommission(t *testing.T) {
+	flags := &decommissionFlags{
+		Namespace:  "volcano-system",
+		Release:    "volcano",
+		SecretName: "volcano-admission-secret",
+	}
+	objects := []runtime.Object{
+		admissionDeployment("jobs=vap,queues=vap,pods=both"),
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service-jobs-validate"}},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service-queues-validate"}},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service-pods-validate"}},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "other-webhook"}},
+		&admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service-jobs-mutate"}},
+		&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service", Namespace: "volcano-system"}},
+	}
+	// the policy of queues is not healthy, its latest generation is not observed yet
+	unhealthy := enforcingPolicy("queue-weight", "queues")
+	unhealthy[0].(*admissionregistrationv1.ValidatingAdmissionPolicy).Generation = 2
+	objects = append(objects, enforcingPolicy("job-min-available", "jobs")...)
+	objects = append(objects, unhealthy...)
+	client := fake.NewSimpleClientset(objects...)
+
+	if _, _, err := planDecommission(context.TODO(), client, flags); err == nil || !strings.Contains(err.Error(), "enforces the validation of queues,") {
+		t.Fatalf("expected decommission to be refused for queues, got %v", err)
+	}
+
+	flags.Force = true
+	actions, remaining, err := planDecommission(context.TODO(), client, flags)
+	if err != nil {
+		t.Fatalf("failed to plan decommission: %v", err)
+	}
+	// the webhooks of pods and the mutating webhooks still route to the deployment
+	expected := []string{
+		"delete validatingwebhookconfiguration volcano-admission-service-jobs-validate",
+		"delete validatingwebhookconfiguration volcano-admission-service-queues-validate",
+	}
+	if !reflect.DeepEqual(descriptions(actions), expected) {
+		t.Errorf("expected actions %v, got %v", expected, descriptions(actions))
+	}
+	expectedRemaining := []string{"volcano-admission-service-pods-validate", "volcano-admission-service-jobs-mutate"}
+	if !reflect.DeepEqual(remaining, expectedRemaining) {
+		t.Errorf("expected remaining webhooks %v, got %v", expectedRemaining, remaining)
+	}
+}
+
+func TestPlanDecommissionDeployment(t *testing.T) {
+	flags := &decommissionFlags{
+		Namespace:  "volcano-system",
+		Release:    "volcano",
+		SecretName: "volcano-admission-secret",
+	}
+	objects := []runtime.Object{
+		admissionDeployment("jobs=vap"),
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service-jobs-validate"}},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "other-webhook"}},
+		&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "volcano-admission-service", Namespace: "volcano-system"}},
+	}
+	objects = append(objects, enforcingPolicy("job-min-available", "jobs")...)
+	client := fake.NewSimpleClientset(objects...)
+
+	actions, remaining, err := planDecommission(context.TODO(), client, flags)
+	if err != nil {
+		t.Fatalf("failed to plan decommission: %v", err)
+	}
+	// nothing routes to the deployment anymore, the secret does not exist and is skipped
+	expected := []string{
+		"delete validatingwebhookconfiguration volcano-admission-service-jobs-validate",
+		"scale deployment volcano-system/volcano-admission to 0 replicas",
+		"delete service volcano-system/volcano-admission-service",
+	}
+	if !reflect.DeepEqual(descriptions(actions), expected) || len(remaining) != 0 {
+		t.Fatalf("expected actions %v and no remaining webhook, got %v and %v", expected, descriptions(actions), remaining)
+	}
+
+	for _, a := range actions {
+		if err := a.run(context.TODO()); err != nil {
+			t.Fatalf("failed to %s: %v", a.description, err)
+		}
+	}
+	actions, _, err = planDecommission(context.TODO(), client, flags)
+	if err != nil {
+		t.Fatalf("failed to plan decommission: %v", err)
+	}
+	if len(actions) != 0 {
+		t.Errorf("expected nothing left to decommission, got %v", descriptions(actions))
+	}
+	if _, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), "other-webhook", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected other webhook to be kept: %v", err)
+	}
+}
+
+func TestValidationBackends(t *testing.T) {
+	deployment := admissionDeployment("jobs=vap, queues=both")
+	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, v1.Container{
+		Command: []string{"vc-webhook-manager", "--validation-backends", "pods=webhook"},
+	})
+	expected := map[string]string{"jobs": "vap", "queues": "both", "pods": "webhook"}
+	if backends := validationBackends(deployment); !reflect.DeepEqual(backends, expected) {
+		t.Errorf("expected backends %v, got %v", expected, backends)
+	}
+}
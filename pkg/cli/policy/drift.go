@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	admissionregistrationdefaults "k8s.io/kubernetes/pkg/apis/admissionregistration/v1"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+)
+
+type driftFlags struct {
+	util.CommonFlags
+	// PolicyPath is the file or directory of the source ValidatingAdmissionPolicy manifests
+	PolicyPath string
+	// Revert restores the installed objects to their source
+	Revert bool
+	// Interval is the period of the drift checks, the drift is only checked once if it is 0
+	Interval time.Duration
+	// MetricsAddress is the address the drift metric is served on when checking periodically
+	MetricsAddress string
+}
+
+var driftPolicyFlags = &driftFlags{}
+
+// InitDriftFlags is used to init all flags during drift detection.
+func InitDriftFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &driftPolicyFlags.CommonFlags)
+	cmd.Flags().StringVarP(&driftPolicyFlags.PolicyPath, "policies", "p", "", "the file or directory of the source ValidatingAdmissionPolicy and binding manifests")
+	cmd.Flags().BoolVarP(&driftPolicyFlags.Revert, "revert", "", false, "restore drifted and missing objects to their source")
+	cmd.Flags().DurationVarP(&driftPolicyFlags.Interval, "interval", "", 0, "check the drift periodically and serve the drift metric, the drift is only checked once if 0")
+	cmd.Flags().StringVarP(&driftPolicyFlags.MetricsAddress, "metrics-address", "", ":8080", "the address the drift metric is served on when checking periodically")
+}
+
+const (
+	driftStateMatch   = "match"
+	driftStateDrifted = "drifted"
+	driftStateMissing = "missing"
+)
+
+// policyDrift is 1 for the current drift state of each source policy and binding, and 0 for the others.
+var policyDrift = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: "volcano",
+		Name:      "policy_drift",
+		Help:      "The drift state of the installed admission policies and bindings from their source, one of match, drifted and missing",
+	}, []string{"kind", "name", "state"},
+)
+
+// drift is the difference between the source of a policy or binding and the installed one.
+type drift struct {
+	Kind string
+	Name string
+	// Missing is true if the object is not installed.
+	Missing bool
+	// Diff is the difference of the specs, from installed to source.
+	Diff string
+
+	revert func(ctx context.Context) error
+}
+
+// DetectPolicyDrift compares the specs of the installed policies and bindings with their source
+// manifests, and reports the objects which are missing or were edited. Defaults are applied to the
+// source before comparing, so only semantic differences are reported. Objects which are installed
+// but have no source are not reported.
+//
+// If an interval is set, the drift is checked periodically until ctx is done, and the drift state of
+// every source object is served as the volcano_policy_drift metric.
+func DetectPolicyDrift(ctx context.Context) error {
+	if driftPolicyFlags.PolicyPath == "" {
+		return fmt.Errorf("policies must be specified")
+	}
+	policies, bindings, err := loadAdmissionPolicies(driftPolicyFlags.PolicyPath)
+	if err != nil {
+		return err
+	}
+
+	config, err := util.BuildConfig(driftPolicyFlags.Master, driftPolicyFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	if driftPolicyFlags.Interval == 0 {
+		drifted, err := checkDrift(ctx, client, policies, bindings, driftPolicyFlags.Revert)
+		if err != nil {
+			return err
+		}
+		if drifted > 0 {
+			return fmt.Errorf("%d of %d object(s) drifted from their source", drifted, len(policies)+len(bindings))
+		}
+		return nil
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(policyDrift)
+	server := &http.Server{
+		Addr:              driftPolicyFlags.MetricsAddress,
+		Handler:           promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		ReadHeaderTimeout: helpers.DefaultReadHeaderTimeout,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			klog.Errorf("Failed to serve the drift metric: %v", err)
+		}
+	}()
+	defer func() {
+		if err := server.Shutdown(context.Background()); err != nil {
+			klog.Errorf("Failed to shutdown the drift metric server: %v", err)
+		}
+	}()
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := checkDrift(ctx, client, policies, bindings, driftPolicyFlags.Revert); err != nil {
+			klog.Errorf("Failed to check the drift of admission policies: %v", err)
+		}
+	}, driftPolicyFlags.Interval)
+	return nil
+}
+
+// checkDrift reports the drift of the installed objects from their source, reverts them if revert is
+// set, and records their drift state. It returns the number of objects left drifted.
+func checkDrift(ctx context.Context, client kubernetes.Interface,
+	policies []*admissionregistrationv1.ValidatingAdmissionPolicy, bindings []*admissionregistrationv1.ValidatingAdmissionPolicyBinding, revert bool) (int, error) {
+	drifts, err := detectDrift(ctx, client, policies, bindings)
+	if err != nil {
+		return 0, err
+	}
+
+	policyDrift.Reset()
+	for _, p := range policies {
+		policyDrift.WithLabelValues(manifest.KindValidatingAdmissionPolicy, p.Name, driftStateMatch).Set(1)
+	}
+	for _, b := range bindings {
+		policyDrift.WithLabelValues(manifest.KindValidatingAdmissionPolicyBinding, b.Name, driftStateMatch).Set(1)
+	}
+
+	var reverted int
+	for _, d := range drifts {
+		state := driftStateDrifted
+		if d.Missing {
+			state = driftStateMissing
+			fmt.Printf("%s %s is missing\n", d.Kind, d.Name)
+		} else {
+			fmt.Printf("%s %s drifted (-installed +source):\n%s", d.Kind, d.Name, d.Diff)
+		}
+		if revert {
+			if err := d.revert(ctx); err != nil {
+				return 0, fmt.Errorf("failed to revert %s %s: %v", d.Kind, d.Name, err)
+			}
+			fmt.Printf("%s %s reverted\n", d.Kind, d.Name)
+			reverted++
+			continue
+		}
+		policyDrift.WithLabelValues(d.Kind, d.Name, driftStateMatch).Set(0)
+		policyDrift.WithLabelValues(d.Kind, d.Name, state).Set(1)
+	}
+
+	if reverted > 0 {
+		fmt.Printf("%d object(s) reverted to their source\n", reverted)
+	}
+	fmt.Printf("%d object(s) match their source\n", len(policies)+len(bindings)-len(drifts))
+	return len(drifts) - reverted, nil
+}
+
+func detectDrift(ctx context.Context, client kubernetes.Interface,
+	policies []*admissionregistrationv1.ValidatingAdmissionPolicy, bindings []*admissionregistrationv1.ValidatingAdmissionPolicyBinding) ([]drift, error) {
+	policyClient := client.AdmissionregistrationV1().ValidatingAdmissionPolicies()
+	bindingClient := client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings()
+
+	var drifts []drift
+	for _, source := range policies {
+		admissionregistrationdefaults.SetObjectDefaults_ValidatingAdmissionPolicy(source)
+		installed, err := policyClient.Get(ctx, source.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			drifts = append(drifts, drift{Kind: manifest.KindValidatingAdmissionPolicy, Name: source.Name, Missing: true, revert: func(ctx context.Context) error {
+				_, err := policyClient.Create(ctx, source, metav1.CreateOptions{})
+				return err
+			}})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if equality.Semantic.DeepEqual(installed.Spec, source.Spec) {
+			continue
+		}
+		drifts = append(drifts, drift{Kind: manifest.KindValidatingAdmissionPolicy, Name: source.Name, Diff: cmp.Diff(installed.Spec, source.Spec), revert: func(ctx context.Context) error {
+			installed.Spec = source.Spec
+			_, err := policyClient.Update(ctx, installed, metav1.UpdateOptions{})
+			return err
+		}})
+	}
+
+	for _, source := range bindings {
+		admissionregistrationdefaults.SetObjectDefaults_ValidatingAdmissionPolicyBinding(source)
+		installed, err := bindingClient.Get(ctx, source.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			drifts = append(drifts, drift{Kind: manifest.KindValidatingAdmissionPolicyBinding, Name: source.Name, Missing: true, revert: func(ctx context.Context) error {
+				_, err := bindingClient.Create(ctx, source, metav1.CreateOptions{})
+				return err
+			}})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if equality.Semantic.DeepEqual(installed.Spec, source.Spec) {
+			continue
+		}
+		drifts = append(drifts, drift{Kind: manifest.KindValidatingAdmissionPolicyBinding, Name: source.Name, Diff: cmp.Diff(installed.Spec, source.Spec), revert: func(ctx context.Context) error {
+			installed.Spec = source.Spec
+			_, err := bindingClient.Update(ctx, installed, metav1.UpdateOptions{})
+			return err
+		}})
+	}
+	return drifts, nil
+}
+
+// loadAdmissionPolicies loads the ValidatingAdmissionPolicies and their bindings in the manifests under path.
+func loadAdmissionPolicies(path string) ([]*admissionregistrationv1.ValidatingAdmissionPolicy, []*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
+	var policies []*admissionregistrationv1.ValidatingAdmissionPolicy
+	var bindings []*admissionregistrationv1.ValidatingAdmissionPolicyBinding
+	err := manifest.Load(path, func(object *unstructured.Unstructured) error {
+		switch object.GetKind() {
+		case manifest.KindValidatingAdmissionPolicy:
+			p := &admissionregistrationv1.ValidatingAdmissionPolicy{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, p); err != nil {
+				return err
+			}
+			policies = append(policies, p)
+		case manifest.KindValidatingAdmissionPolicyBinding:
+			b := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, b); err != nil {
+				return err
+			}
+			bindings = append(bindings, b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return policies, bindings, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+)
+
+const sourcePolicies = jobMinAvailablePolicy + "---" + queueWeightPolicy
+
+func TestInitDriftFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitDriftFlags(&cmd)
+
+	for _, flag := range []string{"master", "kubeconfig", "policies", "revert", "interval", "metrics-address"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	if err := os.WriteFile(file, []byte(sourcePolicies), 0644); err != nil {
+		t.Fatal(err)
+	}
+	policies, bindings, err := loadAdmissionPolicies(file)
+	if err != nil {
+		t.Fatalf("failed to load policies: %v", err)
+	}
+	if len(policies) != 2 || len(bindings) != 1 {
+		t.Fatalf("expected 2 policies and 1 binding, got %d and %d", len(policies), len(bindings))
+	}
+
+	equivalent := admissionregistrationv1.MatchPolicyType(admissionregistrationv1.Equivalent)
+	fail := admissionregistrationv1.FailurePolicyType(admissionregistrationv1.Fail)
+	installedSpec := admissionregistrationv1.ValidatingAdmissionPolicySpec{
+		FailurePolicy: &fail,
+		MatchConstraints: &admissionregistrationv1.MatchResources{
+			NamespaceSelector: &metav1.LabelSelector{},
+			ObjectSelector:    &metav1.LabelSelector{},
+			MatchPolicy:       &equivalent,
+			ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{{
+				RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{"batch.volcano.sh"},
+						APIVersions: []string{"v1alpha1"},
+						Resources:   []string{"jobs"},
+						Scope:       ptr.To(admissionregistrationv1.AllScopes),
+					},
+				},
+			}},
+		},
+		// manually edited
+		Validations: []admissionregistrationv1.Validation{{Expression: "true"}},
+	}
+	client := fake.NewSimpleClientset(
+		&admissionregistrationv1.ValidatingAdmissionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-min-available"},
+			Spec:       installedSpec,
+		},
+		&admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-min-available"},
+			Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+				PolicyName:        "job-min-available",
+				ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+			},
+		},
+	)
+
+	drifted, err := checkDrift(context.TODO(), client, policies, bindings, false)
+	if err != nil {
+		t.Fatalf("failed to check drift: %v", err)
+	}
+	if drifted != 2 {
+		t.Errorf("expected 2 drifted objects, got %d", drifted)
+	}
+	states := []struct {
+		Kind  string
+		Name  string
+		State string
+	}{
+		{Kind: manifest.KindValidatingAdmissionPolicy, Name: "job-min-available", State: driftStateDrifted},
+		{Kind: manifest.KindValidatingAdmissionPolicy, Name: "queue-weight", State: driftStateMissing},
+		{Kind: manifest.KindValidatingAdmissionPolicyBinding, Name: "job-min-available", State: driftStateMatch},
+	}
+	for _, state := range states {
+		if value := testutil.ToFloat64(policyDrift.WithLabelValues(state.Kind, state.Name, state.State)); value != 1 {
+			t.Errorf("expected %s %s to be %s, got %v", state.Kind, state.Name, state.State, value)
+		}
+	}
+
+	drifts, err := detectDrift(context.TODO(), client, policies, bindings)
+	if err != nil {
+		t.Fatalf("failed to detect drift: %v", err)
+	}
+	if len(drifts) != 2 {
+		t.Fatalf("expected 2 drifts, got %+v", drifts)
+	}
+	if drifts[0].Name != "job-min-available" || drifts[0].Missing || drifts[0].Diff == "" {
+		t.Errorf("expected job-min-available to have drifted, got %+v", drifts[0])
+	}
+	if drifts[1].Name != "queue-weight" || !drifts[1].Missing {
+		t.Errorf("expected queue-weight to be missing, got %+v", drifts[1])
+	}
+
+	if drifted, err := checkDrift(context.TODO(), client, policies, bindings, true); err != nil || drifted != 0 {
+		t.Fatalf("expected all objects to be reverted, got %d drifted: %v", drifted, err)
+	}
+	if value := testutil.ToFloat64(policyDrift.WithLabelValues(manifest.KindValidatingAdmissionPolicy, "queue-weight", driftStateMatch)); value != 1 {
+		t.Errorf("expected queue-weight to match once reverted, got %v", value)
+	}
+	drifts, err = detectDrift(context.TODO(), client, policies, bindings)
+	if err != nil {
+		t.Fatalf("failed to detect drift: %v", err)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("expected no drift after revert, got %+v", drifts)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+// jobMinAvailablePolicy denies jobs with a negative minAvailable.
+const jobMinAvailablePolicy = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-min-available
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "object.spec.minAvailable >= 0"
+    message: "job 'minAvailable' must be >= 0"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: job-min-available
+spec:
+  policyName: job-min-available
+  validationActions: ["Deny"]
+`
+
+// queueWeightPolicy denies queues without a positive weight. It has no binding.
+const queueWeightPolicy = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: queue-weight
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["scheduling.volcano.sh"]
+      apiVersions: ["v1beta1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["queues"]
+  validations:
+  - expression: "object.spec.weight > 0"
+    message: "queue weight must be a positive integer"
+`
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+type impactFlags struct {
+	// CorpusPath is the file or directory of the replayed objects and admission reviews
+	CorpusPath string
+	// BaselinePath is the file or directory of the current policies
+	BaselinePath string
+	// CandidatePath is the file or directory of the changed policies
+	CandidatePath string
+}
+
+var impactPolicyFlags = &impactFlags{}
+
+// InitImpactFlags is used to init all flags during policy impact analysis.
+func InitImpactFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&impactPolicyFlags.CorpusPath, "corpus", "", "", "the file or directory of the objects and AdmissionReviews to replay")
+	cmd.Flags().StringVarP(&impactPolicyFlags.BaselinePath, "baseline", "", "", "the file or directory of the current ValidatingAdmissionPolicy manifests, no policies if empty")
+	cmd.Flags().StringVarP(&impactPolicyFlags.CandidatePath, "candidate", "", "", "the file or directory of the changed ValidatingAdmissionPolicy manifests")
+}
+
+// AnalyzePolicyImpact replays a corpus of objects and admission requests against the current and the
+// changed policies, and reports the requests newly denied and newly allowed by the change.
+//
+// AdmissionReviews in the corpus are replayed as they are, other objects are replayed as their creation.
+func AnalyzePolicyImpact() error {
+	if impactPolicyFlags.CorpusPath == "" || impactPolicyFlags.CandidatePath == "" {
+		return fmt.Errorf("corpus and candidate must be specified")
+	}
+
+	baseline := &shadow.Evaluator{}
+	if impactPolicyFlags.BaselinePath != "" {
+		var err error
+		if baseline, err = shadow.NewEvaluator(impactPolicyFlags.BaselinePath); err != nil {
+			return err
+		}
+	}
+	candidate, err := shadow.NewEvaluator(impactPolicyFlags.CandidatePath)
+	if err != nil {
+		return err
+	}
+	requests, err := loadCorpus(impactPolicyFlags.CorpusPath)
+	if err != nil {
+		return err
+	}
+
+	var warnings []string
+	warnings = append(warnings, baseline.Warnings()...)
+	printWarnings(append(warnings, candidate.Warnings()...))
+	newlyDenied, newlyAllowed := analyzeImpact(baseline, candidate, requests)
+	fmt.Printf("Replayed %d request(s), %d newly denied, %d newly allowed\n", len(requests), len(newlyDenied), len(newlyAllowed))
+	for _, f := range newlyDenied {
+		fmt.Printf("%s is newly denied:\n", f)
+		for _, denial := range f.Denials {
+			fmt.Printf("  - %s\n", denial)
+		}
+	}
+	for _, f := range newlyAllowed {
+		fmt.Printf("%s is newly allowed, it was denied by:\n", f)
+		for _, denial := range f.Denials {
+			fmt.Printf("  - %s\n", denial)
+		}
+	}
+	return nil
+}
+
+// analyzeImpact returns the requests denied by the candidate but not the baseline together with the
+// denials of the candidate, and the requests denied by the baseline but not the candidate together
+// with the denials of the baseline.
+func analyzeImpact(baseline, candidate *shadow.Evaluator, requests []*admissionv1.AdmissionRequest) ([]finding, []finding) {
+	var newlyDenied, newlyAllowed []finding
+	for _, request := range requests {
+		baselineDenials := evaluate(baseline, request)
+		candidateDenials := evaluate(candidate, request)
+		switch {
+		case len(baselineDenials) == 0 && len(candidateDenials) != 0:
+			newlyDenied = append(newlyDenied, newFinding(request, candidateDenials))
+		case len(baselineDenials) != 0 && len(candidateDenials) == 0:
+			newlyAllowed = append(newlyAllowed, newFinding(request, baselineDenials))
+		}
+	}
+	return newlyDenied, newlyAllowed
+}
+
+func newFinding(request *admissionv1.AdmissionRequest, denials []string) finding {
+	return finding{
+		Resource:  request.Resource.Resource,
+		Namespace: request.Namespace,
+		Name:      request.Name,
+		Denials:   denials,
+	}
+}
+
+// loadCorpus loads the admission requests of the AdmissionReviews, and the creation requests of the
+// other objects in the manifests under path.
+func loadCorpus(path string) ([]*admissionv1.AdmissionRequest, error) {
+	var requests []*admissionv1.AdmissionRequest
+	err := manifest.Load(path, func(object *unstructured.Unstructured) error {
+		request, err := requestFor(object)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, request)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// requestFor returns the request of the AdmissionReview, or the creation request of another object.
+func requestFor(object *unstructured.Unstructured) (*admissionv1.AdmissionRequest, error) {
+	if object.GetKind() == manifest.KindAdmissionReview {
+		review := &admissionv1.AdmissionReview{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, review); err != nil {
+			return nil, err
+		}
+		if review.Request == nil {
+			return nil, fmt.Errorf("AdmissionReview %s has no request", object.GetName())
+		}
+		return review.Request, nil
+	}
+
+	gvk := object.GroupVersionKind()
+	if gvk.Kind == "" {
+		return nil, fmt.Errorf("object %s has no kind", object.GetName())
+	}
+	raw, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	return &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind(gvk),
+		Resource:  metav1.GroupVersionResource(gvr),
+		Name:      object.GetName(),
+		Namespace: object.GetNamespace(),
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	admissionv1 "k8s.io/api/admission/v1"
+
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+const corpusManifests = `
+apiVersion: batch.volcano.sh/v1alpha1
+kind: Job
+metadata:
+  name: small
+  namespace: default
+spec:
+  minAvailable: 1
+---
+apiVersion: batch.volcano.sh/v1alpha1
+kind: Job
+metadata:
+  name: large
+  namespace: default
+spec:
+  minAvailable: 100
+---
+apiVersion: batch.volcano.sh/v1alpha1
+kind: Job
+metadata:
+  name: negative
+  namespace: default
+spec:
+  minAvailable: -1
+---
+apiVersion: admission.k8s.io/v1
+kind: AdmissionReview
+request:
+  uid: "1"
+  kind: {group: batch.volcano.sh, version: v1alpha1, kind: Job}
+  resource: {group: batch.volcano.sh, version: v1alpha1, resource: jobs}
+  name: updated
+  namespace: default
+  operation: UPDATE
+  object:
+    apiVersion: batch.volcano.sh/v1alpha1
+    kind: Job
+    metadata: {name: updated, namespace: default}
+    spec: {minAvailable: 50}
+`
+
+const baselinePolicies = jobMinAvailablePolicy
+
+const candidatePolicies = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-min-available
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "object.spec.minAvailable <= 10"
+    message: "job 'minAvailable' must be <= 10"
+`
+
+func TestInitImpactFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitImpactFlags(&cmd)
+
+	for _, flag := range []string{"corpus", "baseline", "candidate"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestLoadCorpus(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "corpus.yaml")
+	if err := os.WriteFile(file, []byte(corpusManifests), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	requests, err := loadCorpus(file)
+	if err != nil {
+		t.Fatalf("failed to load corpus: %v", err)
+	}
+	if len(requests) != 4 {
+		t.Fatalf("expected 4 requests, got %d", len(requests))
+	}
+	if requests[0].Operation != admissionv1.Create || requests[0].Resource.Resource != "jobs" || requests[0].Name != "small" {
+		t.Errorf("expected creation of job small, got %s of %s %s", requests[0].Operation, requests[0].Resource.Resource, requests[0].Name)
+	}
+	if requests[3].Operation != admissionv1.Update || requests[3].Name != "updated" {
+		t.Errorf("expected update of job updated, got %s of %s", requests[3].Operation, requests[3].Name)
+	}
+}
+
+func TestAnalyzeImpact(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"corpus.yaml":    corpusManifests,
+		"baseline.yaml":  baselinePolicies,
+		"candidate.yaml": candidatePolicies,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	requests, err := loadCorpus(filepath.Join(dir, "corpus.yaml"))
+	if err != nil {
+		t.Fatalf("failed to load corpus: %v", err)
+	}
+	baseline, err := shadow.NewEvaluator(filepath.Join(dir, "baseline.yaml"))
+	if err != nil {
+		t.Fatalf("failed to create baseline evaluator: %v", err)
+	}
+	candidate, err := shadow.NewEvaluator(filepath.Join(dir, "candidate.yaml"))
+	if err != nil {
+		t.Fatalf("failed to create candidate evaluator: %v", err)
+	}
+
+	newlyDenied, newlyAllowed := analyzeImpact(baseline, candidate, requests)
+	expectedDenied := []finding{
+		{Resource: "jobs", Namespace: "default", Name: "large", Denials: []string{"job-min-available: job 'minAvailable' must be <= 10"}},
+		{Resource: "jobs", Namespace: "default", Name: "updated", Denials: []string{"job-min-available: job 'minAvailable' must be <= 10"}},
+	}
+	expectedAllowed := []finding{
+		{Resource: "jobs", Namespace: "default", Name: "negative", Denials: []string{"job-min-available: job 'minAvailable' must be >= 0"}},
+	}
+	if !reflect.DeepEqual(newlyDenied, expectedDenied) {
+		t.Errorf("expected newly denied %+v, got %+v", expectedDenied, newlyDenied)
+	}
+	if !reflect.DeepEqual(newlyAllowed, expectedAllowed) {
+		t.Errorf("expected newly allowed %+v, got %+v", expectedAllowed, newlyAllowed)
+	}
+
+	// without a baseline, every request denied by the candidate is newly denied
+	newlyDenied, newlyAllowed = analyzeImpact(&shadow.Evaluator{}, candidate, requests)
+	if len(newlyDenied) != 2 || len(newlyAllowed) != 0 {
+		t.Errorf("expected 2 newly denied and 0 newly allowed, got %+v and %+v", newlyDenied, newlyAllowed)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+
+	"volcano.sh/volcano/pkg/cel"
+)
+
+type playgroundFlags struct {
+	// ListenAddress is the address the playground listens on
+	ListenAddress string
+}
+
+var playgroundPolicyFlags = &playgroundFlags{}
+
+// InitPlaygroundFlags is used to init all flags during serving the CEL playground.
+func InitPlaygroundFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&playgroundPolicyFlags.ListenAddress, "listen", "", ":8080", "the address the playground listens on")
+}
+
+// evaluationRequest is the body of an evaluation request: the expression of a validation and the
+// variables it may reference, evaluated on the operation on the objects.
+type evaluationRequest struct {
+	validationRequest
+	Expression string         `json:"expression"`
+	Variables  []cel.Variable `json:"variables,omitempty"`
+}
+
+// evaluationResponse is the body of an evaluation response.
+type evaluationResponse struct {
+	Allowed   bool             `json:"allowed"`
+	Error     string           `json:"error,omitempty"`
+	Variables []variableResult `json:"variables,omitempty"`
+	// Cost is the runtime cost of the evaluation, out of the budget of the apiserver.
+	Cost int64 `json:"cost"`
+}
+
+type variableResult struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+// ServePlayground serves a CEL playground for policy authors until ctx is done: the page at / and
+// POST /evaluate evaluate a validation expression and its variables on an object, in the
+// environment of ValidatingAdmissionPolicies, and return the verdict, the variable values and the cost.
+func ServePlayground(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              playgroundPolicyFlags.ListenAddress,
+		Handler:           newPlaygroundHandler(cel.NewCompiler()),
+		ReadHeaderTimeout: helpers.DefaultReadHeaderTimeout,
+	}
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			klog.Errorf("Failed to shutdown the playground: %v", err)
+		}
+	}()
+
+	fmt.Printf("Serving the CEL playground on %s\n", playgroundPolicyFlags.ListenAddress)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func newPlaygroundHandler(compiler *cel.Compiler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if _, err := w.Write([]byte(playgroundPage)); err != nil {
+			klog.Errorf("Failed to write the playground page: %v", err)
+		}
+	})
+	mux.HandleFunc("/evaluate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body := &evaluationRequest{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode the request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if body.Expression == "" {
+			http.Error(w, "the expression must be specified", http.StatusBadRequest)
+			return
+		}
+		request, err := admissionRequestFor(&body.validationRequest)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		trace, err := compiler.Trace(r.Context(), cel.Validation{Expression: body.Expression}, body.Variables, request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		response := &evaluationResponse{Allowed: trace.Result.Allowed, Cost: trace.Cost}
+		if trace.Result.Err != nil {
+			response.Error = trace.Result.Err.Error()
+		}
+		for _, variable := range trace.Variables {
+			result := variableResult{Name: variable.Variable.Name, Value: variable.Value}
+			if variable.Err != nil {
+				result.Error = variable.Err.Error()
+			}
+			response.Variables = append(response.Variables, result)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			klog.Errorf("Failed to write the evaluation response: %v", err)
+		}
+	})
+	return mux
+}
+
+// playgroundPage is the page of the playground, variables are entered one per line as "name: expression".
+const playgroundPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Volcano CEL playground</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+textarea { width: 100%; font-family: monospace; }
+pre { background: #f4f4f4; padding: 1em; }
+</style>
+</head>
+<body>
+<h1>Volcano CEL playground</h1>
+<p>Operation <select id="operation"><option>CREATE</option><option>UPDATE</option><option>DELETE</option></select></p>
+<p>Object (JSON)<br><textarea id="object" rows="12">{"apiVersion": "batch.volcano.sh/v1alpha1", "kind": "Job", "metadata": {"name": "job", "namespace": "default"}, "spec": {"minAvailable": 1, "tasks": [{"name": "worker", "replicas": 2}]}}</textarea></p>
+<p>Old object (JSON, for UPDATE and DELETE)<br><textarea id="oldObject" rows="6"></textarea></p>
+<p>Variables (one per line, name: expression)<br><textarea id="variables" rows="4">replicas: object.spec.tasks.map(t, t.replicas).sum()</textarea></p>
+<p>Expression<br><textarea id="expression" rows="3">object.spec.minAvailable <= variables.replicas</textarea></p>
+<button onclick="evaluate()">Evaluate</button>
+<pre id="result"></pre>
+<script>
+async function evaluate() {
+  const result = document.getElementById("result");
+  try {
+    const value = id => document.getElementById(id).value.trim();
+    const body = {operation: value("operation"), expression: value("expression"), variables: []};
+    if (value("object")) body.object = JSON.parse(value("object"));
+    if (value("oldObject")) body.oldObject = JSON.parse(value("oldObject"));
+    for (const line of value("variables").split("\n")) {
+      const i = line.indexOf(":");
+      if (i > 0) body.variables.push({name: line.slice(0, i).trim(), expression: line.slice(i + 1).trim()});
+    }
+    const response = await fetch("evaluate", {method: "POST", headers: {"Content-Type": "application/json"}, body: JSON.stringify(body)});
+    const text = await response.text();
+    result.textContent = response.ok ? JSON.stringify(JSON.parse(text), null, 2) : text;
+  } catch (e) {
+    result.textContent = e;
+  }
+}
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/pkg/cel"
+)
+
+func TestInitPlaygroundFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitPlaygroundFlags(&cmd)
+
+	if cmd.Flag("listen") == nil {
+		t.Errorf("Could not find the flag listen")
+	}
+}
+
+func TestPlaygroundHandler(t *testing.T) {
+	server := httptest.NewServer(newPlaygroundHandler(cel.NewCompiler()))
+	defer server.Close()
+
+	page, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("failed to get the page: %v", err)
+	}
+	content, err := io.ReadAll(page.Body)
+	page.Body.Close()
+	if err != nil || page.StatusCode != http.StatusOK || !strings.Contains(string(content), "Volcano CEL playground") {
+		t.Errorf("expected the playground page, got status %d: %v", page.StatusCode, err)
+	}
+
+	job := `{"apiVersion":"batch.volcano.sh/v1alpha1","kind":"Job","metadata":{"name":"job","namespace":"default"},"spec":{"minAvailable":3,"tasks":[{"name":"worker","replicas":2}]}}`
+	testCases := []struct {
+		Name           string
+		Body           string
+		ExpectStatus   int
+		ExpectResponse *evaluationResponse
+	}{
+		{
+			Name:         "expression with variables",
+			Body:         `{"object":` + job + `,"expression":"object.spec.minAvailable <= variables.replicas","variables":[{"name":"replicas","expression":"object.spec.tasks.map(t, t.replicas).sum()"},{"name":"queue","expression":"object.spec.queue"}]}`,
+			ExpectStatus: http.StatusOK,
+			ExpectResponse: &evaluationResponse{Allowed: false, Variables: []variableResult{
+				{Name: "replicas", Value: float64(2)},
+				{Name: "queue", Error: "composited variable \"queue\" fails to evaluate: no such key: queue"},
+			}},
+		},
+		{
+			Name:           "operation of the request",
+			Body:           `{"operation":"DELETE","oldObject":` + job + `,"expression":"request.operation == 'DELETE' && object == null"}`,
+			ExpectStatus:   http.StatusOK,
+			ExpectResponse: &evaluationResponse{Allowed: true},
+		},
+		{
+			Name:         "invalid expression is rejected",
+			Body:         `{"object":` + job + `,"expression":"object.spec.minAvailable >="}`,
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "missing expression is rejected",
+			Body:         `{"object":` + job + `}`,
+			ExpectStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			response, err := http.Post(server.URL+"/evaluate", "application/json", strings.NewReader(testCase.Body))
+			if err != nil {
+				t.Fatalf("failed to send the request: %v", err)
+			}
+			defer response.Body.Close()
+
+			if response.StatusCode != testCase.ExpectStatus {
+				t.Fatalf("expected status %d, got %d", testCase.ExpectStatus, response.StatusCode)
+			}
+			if testCase.ExpectResponse == nil {
+				return
+			}
+			body := &evaluationResponse{}
+			if err := json.NewDecoder(response.Body).Decode(body); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+			if body.Cost <= 0 {
+				t.Errorf("expected positive cost, got %d", body.Cost)
+			}
+			body.Cost = 0
+			if !reflect.DeepEqual(body, testCase.ExpectResponse) {
+				t.Errorf("expected response %+v, got %+v", testCase.ExpectResponse, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/webhooks/preflight"
+)
+
+type preflightFlags struct {
+	util.CommonFlags
+	// ParamResources are the resources the params of the policies are read from
+	ParamResources []string
+}
+
+var preflightPolicyFlags = &preflightFlags{}
+
+// InitPreflightFlags is used to init all flags during pre-flight checking.
+func InitPreflightFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &preflightPolicyFlags.CommonFlags)
+	cmd.Flags().StringSliceVarP(&preflightPolicyFlags.ParamResources, "param-resources", "", []string{"configmaps"}, "the resources the params of the policies are read from, in resource.group form")
+}
+
+// PreflightPolicies checks the cluster is able to enforce admission policies, and fails if a required
+// check does not pass.
+func PreflightPolicies(ctx context.Context) error {
+	config, err := util.BuildConfig(preflightPolicyFlags.Master, preflightPolicyFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	opts := preflight.Options{}
+	for _, resource := range preflightPolicyFlags.ParamResources {
+		opts.ParamResources = append(opts.ParamResources, schema.ParseGroupResource(resource))
+	}
+	report, err := preflight.Run(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+
+	PrintPreflightReport(report, os.Stdout)
+	if !report.Ready() {
+		return fmt.Errorf("cluster is not ready to enforce admission policies")
+	}
+	return nil
+}
+
+// PrintPreflightReport prints the result of each check of the report.
+func PrintPreflightReport(report *preflight.Report, writer io.Writer) {
+	fmt.Fprintf(writer, "apiserver %s\n", report.ServerVersion)
+	for _, c := range report.Checks {
+		status := "PASS"
+		if !c.Passed {
+			status = "WARN"
+			if c.Required {
+				status = "FAIL"
+			}
+		}
+		if c.Message == "" {
+			fmt.Fprintf(writer, "[%s] %s\n", status, c.Name)
+			continue
+		}
+		fmt.Fprintf(writer, "[%s] %s: %s\n", status, c.Name, c.Message)
+	}
+}
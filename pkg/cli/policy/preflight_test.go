@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/pkg/webhooks/preflight"
+)
+
+func TestInitPreflightFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitPreflightFlags(&cmd)
+
+	for _, flag := range []string{"master", "kubeconfig", "param-resources"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestPrintPreflightReport(t *testing.T) {
+	report := &preflight.Report{
+		ServerVersion: "v1.31.0",
+		Checks: []preflight.Check{
+			{Name: "apiserver version", Required: true, Passed: true},
+			{Name: "ValidatingAdmissionPolicy API", Required: true, Message: "not served"},
+			{Name: "CEL library semver", Message: "not available"},
+		},
+	}
+
+	var buf bytes.Buffer
+	PrintPreflightReport(report, &buf)
+	expected := `apiserver v1.31.0
+[PASS] apiserver version
+[FAIL] ValidatingAdmissionPolicy API: not served
+[WARN] CEL library semver: not available
+`
+	if buf.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+type scanFlags struct {
+	util.CommonFlags
+	// PolicyPath is the file or directory of the ValidatingAdmissionPolicy manifests
+	PolicyPath string
+	// Namespace is the namespace of the scanned objects
+	Namespace string
+	// AllNamespace all namespace flag
+	AllNamespace bool
+}
+
+var scanPolicyFlags = &scanFlags{}
+
+// InitScanFlags is used to init all flags during policy scanning.
+func InitScanFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &scanPolicyFlags.CommonFlags)
+	cmd.Flags().StringVarP(&scanPolicyFlags.PolicyPath, "policies", "p", "", "the file or directory of the ValidatingAdmissionPolicy manifests to scan against")
+	cmd.Flags().StringVarP(&scanPolicyFlags.Namespace, "namespace", "n", "default", "the namespace of the scanned objects, queues and hypernodes are always scanned")
+	cmd.Flags().BoolVarP(&scanPolicyFlags.AllNamespace, "all-namespaces", "", false, "scan objects in all namespaces")
+}
+
+// object is a live Volcano object together with the resource it is served as.
+type object struct {
+	resource schema.GroupVersionResource
+	kind     schema.GroupVersionKind
+	meta     metav1.Object
+	obj      runtime.Object
+}
+
+// finding is an object denied by the policies.
+type finding struct {
+	Resource  string
+	Namespace string
+	Name      string
+	Denials   []string
+}
+
+func (f finding) String() string {
+	if f.Namespace == "" {
+		return fmt.Sprintf("%s %s", f.Resource, f.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", f.Resource, f.Namespace, f.Name)
+}
+
+// ScanPolicies evaluates the Volcano objects in the cluster against the policies, and reports the
+// objects which would be denied on their next update once the policies are enforced.
+func ScanPolicies(ctx context.Context) error {
+	if scanPolicyFlags.PolicyPath == "" {
+		return fmt.Errorf("policies must be specified")
+	}
+	evaluator, err := shadow.NewEvaluator(scanPolicyFlags.PolicyPath)
+	if err != nil {
+		return err
+	}
+
+	config, err := util.BuildConfig(scanPolicyFlags.Master, scanPolicyFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	if scanPolicyFlags.AllNamespace {
+		scanPolicyFlags.Namespace = ""
+	}
+
+	objects, err := listObjects(ctx, versioned.NewForConfigOrDie(config), scanPolicyFlags.Namespace)
+	if err != nil {
+		return err
+	}
+	findings, err := scanObjects(evaluator, objects)
+	if err != nil {
+		return err
+	}
+
+	printWarnings(evaluator.Warnings())
+	for _, f := range findings {
+		fmt.Printf("%s would be denied:\n", f)
+		for _, denial := range f.Denials {
+			fmt.Printf("  - %s\n", denial)
+		}
+	}
+	if len(findings) > 0 {
+		return fmt.Errorf("%d of %d object(s) would be denied on their next update", len(findings), len(objects))
+	}
+	if len(evaluator.Warnings()) > 0 {
+		fmt.Printf("None of %d object(s) would be denied on their next update by the evaluated policies, see the warnings above\n", len(objects))
+		return nil
+	}
+	fmt.Printf("None of %d object(s) would be denied on their next update\n", len(objects))
+	return nil
+}
+
+// printWarnings prints the parts of the policies which were not evaluated, as the reported verdicts
+// may differ from the ones of the apiserver.
+func printWarnings(warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	fmt.Printf("WARNING: verdicts may differ from the apiserver, the following is not evaluated:\n")
+	for _, warning := range warnings {
+		fmt.Printf("  - %s\n", warning)
+	}
+}
+
+// listObjects lists the jobs, cronjobs, podgroups, jobflows and jobtemplates in the namespace, and all the
+// queues and hypernodes.
+func listObjects(ctx context.Context, client versioned.Interface, namespace string) ([]object, error) {
+	var objects []object
+
+	jobs, err := client.BatchV1alpha1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		objects = append(objects, newObject(batchv1alpha1.SchemeGroupVersion, "jobs", "Job", &jobs.Items[i]))
+	}
+
+	podGroups, err := client.SchedulingV1beta1().PodGroups(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podGroups.Items {
+		objects = append(objects, newObject(schedulingv1beta1.SchemeGroupVersion, "podgroups", "PodGroup", &podGroups.Items[i]))
+	}
+
+	queues, err := client.SchedulingV1beta1().Queues().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range queues.Items {
+		objects = append(objects, newObject(schedulingv1beta1.SchemeGroupVersion, "queues", "Queue", &queues.Items[i]))
+	}
+
+	cronJobs, err := client.BatchV1alpha1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range cronJobs.Items {
+		objects = append(objects, newObject(batchv1alpha1.SchemeGroupVersion, "cronjobs", "CronJob", &cronJobs.Items[i]))
+	}
+
+	hyperNodes, err := client.TopologyV1alpha1().HyperNodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range hyperNodes.Items {
+		objects = append(objects, newObject(topologyv1alpha1.SchemeGroupVersion, "hypernodes", "HyperNode", &hyperNodes.Items[i]))
+	}
+
+	jobFlows, err := client.FlowV1alpha1().JobFlows(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobFlows.Items {
+		objects = append(objects, newObject(flowv1alpha1.SchemeGroupVersion, "jobflows", "JobFlow", &jobFlows.Items[i]))
+	}
+
+	jobTemplates, err := client.FlowV1alpha1().JobTemplates(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobTemplates.Items {
+		objects = append(objects, newObject(flowv1alpha1.SchemeGroupVersion, "jobtemplates", "JobTemplate", &jobTemplates.Items[i]))
+	}
+
+	return objects, nil
+}
+
+func newObject(gv schema.GroupVersion, resource, kind string, obj interface {
+	metav1.Object
+	runtime.Object
+}) object {
+	// the items of a list are returned without their type meta, which the policies may refer to
+	obj.GetObjectKind().SetGroupVersionKind(gv.WithKind(kind))
+	return object{
+		resource: gv.WithResource(resource),
+		kind:     gv.WithKind(kind),
+		meta:     obj,
+		obj:      obj,
+	}
+}
+
+// scanObjects evaluates each object as a no-op update of itself.
+func scanObjects(evaluator *shadow.Evaluator, objects []object) ([]finding, error) {
+	var findings []finding
+	for _, o := range objects {
+		raw, err := json.Marshal(o.obj)
+		if err != nil {
+			return nil, err
+		}
+		request := &admissionv1.AdmissionRequest{
+			UID:       o.meta.GetUID(),
+			Kind:      metav1.GroupVersionKind(o.kind),
+			Resource:  metav1.GroupVersionResource(o.resource),
+			Name:      o.meta.GetName(),
+			Namespace: o.meta.GetNamespace(),
+			Operation: admissionv1.Update,
+			Object:    runtime.RawExtension{Raw: raw},
+			OldObject: runtime.RawExtension{Raw: raw},
+		}
+
+		denials := evaluate(evaluator, request)
+		if len(denials) == 0 {
+			continue
+		}
+		findings = append(findings, finding{
+			Resource:  o.resource.Resource,
+			Namespace: request.Namespace,
+			Name:      request.Name,
+			Denials:   denials,
+		})
+	}
+	return findings, nil
+}
+
+// evaluate returns the messages of the policies denying the request.
+func evaluate(evaluator *shadow.Evaluator, request *admissionv1.AdmissionRequest) []string {
+	_, denials, err := evaluator.Evaluate(request)
+	if err != nil {
+		// policies fail closed by default, so a request failing the evaluation is denied as well
+		return []string{err.Error()}
+	}
+	return denials
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+const policies = jobMinAvailablePolicy + "---" + queueWeightPolicy
+
+func TestInitScanFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitScanFlags(&cmd)
+
+	for _, flag := range []string{"master", "kubeconfig", "policies", "namespace", "all-namespaces"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestScanObjects(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	if err := os.WriteFile(file, []byte(policies), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := shadow.NewEvaluator(file)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+
+	client := fake.NewSimpleClientset(
+		&batchv1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "default"},
+			Spec:       batchv1alpha1.JobSpec{MinAvailable: 1},
+		},
+		&batchv1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "default"},
+			Spec:       batchv1alpha1.JobSpec{MinAvailable: -1},
+		},
+		&batchv1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "other"},
+			Spec:       batchv1alpha1.JobSpec{MinAvailable: -1},
+		},
+		&schedulingv1beta1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "weighted"},
+			Spec:       schedulingv1beta1.QueueSpec{Weight: 1},
+		},
+		// the weight is omitted, which fails the evaluation
+		&schedulingv1beta1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+		},
+		&batchv1alpha1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		},
+		&topologyv1alpha1.HyperNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "rack-1"},
+		},
+	)
+
+	objects, err := listObjects(context.TODO(), client, "default")
+	if err != nil {
+		t.Fatalf("failed to list objects: %v", err)
+	}
+	if len(objects) != 6 {
+		t.Fatalf("expected 6 objects, got %d", len(objects))
+	}
+	for _, o := range objects {
+		if o.obj.GetObjectKind().GroupVersionKind() != o.kind {
+			t.Errorf("expected the kind of %s to be set, got %v", o.meta.GetName(), o.obj.GetObjectKind().GroupVersionKind())
+		}
+	}
+
+	findings, err := scanObjects(evaluator, objects)
+	if err != nil {
+		t.Fatalf("failed to scan objects: %v", err)
+	}
+	expected := []finding{
+		{
+			Resource:  "jobs",
+			Namespace: "default",
+			Name:      "invalid",
+			Denials:   []string{"job-min-available: job 'minAvailable' must be >= 0"},
+		},
+		{
+			Resource: "queues",
+			Name:     "legacy",
+			Denials:  []string{"failed to evaluate policy queue-weight: expression 'object.spec.weight > 0' resulted in error: no such key: weight"},
+		},
+	}
+	if !reflect.DeepEqual(findings, expected) {
+		t.Errorf("expected findings %+v, got %+v", expected, findings)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+type serveFlags struct {
+	// PolicyPath is the file or directory of the served ValidatingAdmissionPolicy manifests
+	PolicyPath string
+	// ListenAddress is the address the validation API listens on
+	ListenAddress string
+}
+
+var servePolicyFlags = &serveFlags{}
+
+// InitServeFlags is used to init all flags during serving policy verdicts.
+func InitServeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&servePolicyFlags.PolicyPath, "policies", "p", "", "the file or directory of the ValidatingAdmissionPolicy manifests to serve")
+	cmd.Flags().StringVarP(&servePolicyFlags.ListenAddress, "listen", "", ":8080", "the address the validation API listens on")
+}
+
+// validationRequest is the body of a validation request. Object is the object of CREATE and UPDATE
+// requests, and OldObject the one of UPDATE and DELETE requests. An AdmissionReview object is
+// validated as it is, regardless of the operation.
+type validationRequest struct {
+	Operation admissionv1.Operation  `json:"operation,omitempty"`
+	Object    map[string]interface{} `json:"object,omitempty"`
+	OldObject map[string]interface{} `json:"oldObject,omitempty"`
+}
+
+// validationResponse is the body of a validation response. The request is allowed if it is allowed
+// by every policy matching it.
+type validationResponse struct {
+	Allowed  bool             `json:"allowed"`
+	Verdicts []shadow.Verdict `json:"verdicts"`
+	Warnings []string         `json:"warnings,omitempty"`
+}
+
+// ServePolicies serves the verdicts of the policies over HTTP until ctx is done: POST /validate
+// with an object and an operation returns the verdict of every policy matching the request.
+func ServePolicies(ctx context.Context) error {
+	if servePolicyFlags.PolicyPath == "" {
+		return fmt.Errorf("policies must be specified")
+	}
+	evaluator, err := shadow.NewEvaluator(servePolicyFlags.PolicyPath)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              servePolicyFlags.ListenAddress,
+		Handler:           newValidationHandler(evaluator),
+		ReadHeaderTimeout: helpers.DefaultReadHeaderTimeout,
+	}
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			klog.Errorf("Failed to shutdown the validation API: %v", err)
+		}
+	}()
+
+	printWarnings(evaluator.Warnings())
+	fmt.Printf("Serving the policies of %s on %s\n", servePolicyFlags.PolicyPath, servePolicyFlags.ListenAddress)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func newValidationHandler(evaluator *shadow.Evaluator) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/validate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body := &validationRequest{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode the request: %v", err), http.StatusBadRequest)
+			return
+		}
+		request, err := admissionRequestFor(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		response := &validationResponse{Allowed: true, Verdicts: evaluator.Verdicts(request), Warnings: evaluator.Warnings()}
+		for _, verdict := range response.Verdicts {
+			response.Allowed = response.Allowed && verdict.Allowed
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			klog.Errorf("Failed to write the validation response: %v", err)
+		}
+	})
+	return mux
+}
+
+// admissionRequestFor returns the admission request of the operation on the objects of the body.
+func admissionRequestFor(body *validationRequest) (*admissionv1.AdmissionRequest, error) {
+	operation := body.Operation
+	if operation == "" {
+		operation = admissionv1.Create
+	}
+	switch operation {
+	case admissionv1.Create, admissionv1.Update, admissionv1.Delete, admissionv1.Connect:
+	default:
+		return nil, fmt.Errorf("unsupported operation %s", operation)
+	}
+
+	object := body.Object
+	if operation == admissionv1.Delete {
+		object = body.OldObject
+	}
+	if object == nil {
+		return nil, fmt.Errorf("the object of the %s request must be specified", operation)
+	}
+	if operation == admissionv1.Update && body.OldObject == nil {
+		return nil, fmt.Errorf("the old object of the UPDATE request must be specified")
+	}
+
+	u := &unstructured.Unstructured{Object: object}
+	request, err := requestFor(u)
+	if err != nil || u.GetKind() == manifest.KindAdmissionReview {
+		return request, err
+	}
+
+	request.Operation = operation
+	if operation == admissionv1.Delete {
+		request.OldObject, request.Object = request.Object, runtime.RawExtension{}
+	} else if body.OldObject != nil {
+		raw, err := json.Marshal(body.OldObject)
+		if err != nil {
+			return nil, err
+		}
+		request.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	return request, nil
+}
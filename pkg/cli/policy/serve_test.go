@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+func TestInitServeFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitServeFlags(&cmd)
+
+	for _, flag := range []string{"policies", "listen"} {
+		if cmd.Flag(flag) == nil {
+			t.Errorf("Could not find the flag %s", flag)
+		}
+	}
+}
+
+func TestValidationHandler(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	if err := os.WriteFile(file, []byte(jobMinAvailablePolicy+"---"+queueWeightPolicy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := shadow.NewEvaluator(file)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	server := httptest.NewServer(newValidationHandler(evaluator))
+	defer server.Close()
+
+	job := func(minAvailable string) string {
+		return `{"apiVersion":"batch.volcano.sh/v1alpha1","kind":"Job","metadata":{"name":"job","namespace":"default"},"spec":{"minAvailable":` + minAvailable + `}}`
+	}
+	testCases := []struct {
+		Name           string
+		Method         string
+		Body           string
+		ExpectStatus   int
+		ExpectResponse *validationResponse
+	}{
+		{
+			Name:         "valid job is allowed",
+			Method:       http.MethodPost,
+			Body:         `{"object":` + job("1") + `}`,
+			ExpectStatus: http.StatusOK,
+			ExpectResponse: &validationResponse{Allowed: true, Verdicts: []shadow.Verdict{
+				{Policy: "job-min-available", Allowed: true},
+			}},
+		},
+		{
+			Name:         "invalid job update is denied",
+			Method:       http.MethodPost,
+			Body:         `{"operation":"UPDATE","object":` + job("-1") + `,"oldObject":` + job("1") + `}`,
+			ExpectStatus: http.StatusOK,
+			ExpectResponse: &validationResponse{Allowed: false, Verdicts: []shadow.Verdict{
+				{Policy: "job-min-available", Allowed: false, Denials: []string{"job 'minAvailable' must be >= 0"}},
+			}},
+		},
+		{
+			Name:           "delete matches no policy",
+			Method:         http.MethodPost,
+			Body:           `{"operation":"DELETE","oldObject":` + job("-1") + `}`,
+			ExpectStatus:   http.StatusOK,
+			ExpectResponse: &validationResponse{Allowed: true},
+		},
+		{
+			Name:         "update without old object is rejected",
+			Method:       http.MethodPost,
+			Body:         `{"operation":"UPDATE","object":` + job("1") + `}`,
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "object without kind is rejected",
+			Method:       http.MethodPost,
+			Body:         `{"object":{"metadata":{"name":"job"}}}`,
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "only POST is supported",
+			Method:       http.MethodGet,
+			ExpectStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			request, err := http.NewRequest(testCase.Method, server.URL+"/validate", strings.NewReader(testCase.Body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("failed to send the request: %v", err)
+			}
+			defer response.Body.Close()
+
+			if response.StatusCode != testCase.ExpectStatus {
+				t.Fatalf("expected status %d, got %d", testCase.ExpectStatus, response.StatusCode)
+			}
+			if testCase.ExpectResponse == nil {
+				return
+			}
+			body := &validationResponse{}
+			if err := json.NewDecoder(response.Body).Decode(body); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+			if !reflect.DeepEqual(body, testCase.ExpectResponse) {
+				t.Errorf("expected response %+v, got %+v", testCase.ExpectResponse, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dag checks that the dependencies between the flows of a JobFlow form a DAG.
+//
+// It is used by the jobflow validating webhook and by vcctl, and is the part of JobFlow validation
+// which stays in the webhook when other validations move to ValidatingAdmissionPolicy: CEL has
+// neither recursion nor unbounded loops, so a policy cannot traverse the dependency graph to find
+// cycles of arbitrary length.
+package dag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+var (
+	// ErrFlowNotDefined is returned when a flow depends on a flow which is not defined.
+	ErrFlowNotDefined = errors.New("vertex is not defined")
+	// ErrCycle is returned when the dependencies of flows form a cycle.
+	ErrCycle = errors.New("dependency cycle")
+)
+
+type color int
+
+const (
+	unvisited color = iota
+	visiting
+	visited
+)
+
+// Validate returns an error for each dependency on an undefined flow and for each dependency cycle
+// of the flows, e.g. "dependency cycle: a -> b -> a". Errors are reported in the order of the flows.
+func Validate(flows []flowv1alpha1.Flow) []error {
+	var errs []error
+
+	graph := make(map[string][]string, len(flows))
+	for _, flow := range flows {
+		graph[flow.Name] = nil
+	}
+	for _, flow := range flows {
+		for _, target := range targets(flow) {
+			if _, found := graph[target]; !found {
+				errs = append(errs, fmt.Errorf("%w: %s, depended on by flow %s", ErrFlowNotDefined, target, flow.Name))
+				continue
+			}
+			graph[flow.Name] = append(graph[flow.Name], target)
+		}
+	}
+
+	colors := make(map[string]color, len(flows))
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		colors[name] = visiting
+		path = append(path, name)
+		for _, target := range graph[name] {
+			switch colors[target] {
+			case unvisited:
+				visit(target)
+			case visiting:
+				errs = append(errs, fmt.Errorf("%w: %s", ErrCycle, cycle(path, target)))
+			}
+		}
+		path = path[:len(path)-1]
+		colors[name] = visited
+	}
+	for _, flow := range flows {
+		if colors[flow.Name] == unvisited {
+			visit(flow.Name)
+		}
+	}
+
+	return errs
+}
+
+func targets(flow flowv1alpha1.Flow) []string {
+	if flow.DependsOn == nil {
+		return nil
+	}
+	return flow.DependsOn.Targets
+}
+
+// cycle returns the cycle closed by the edge from the end of path to target, which is in path.
+func cycle(path []string, target string) string {
+	for i := range path {
+		if path[i] == target {
+			return strings.Join(append(append([]string{}, path[i:]...), target), " -> ")
+		}
+	}
+	return target
+}
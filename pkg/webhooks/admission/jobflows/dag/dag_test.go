@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dag
+
+import (
+	"reflect"
+	"testing"
+
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+func flow(name string, targets ...string) flowv1alpha1.Flow {
+	f := flowv1alpha1.Flow{Name: name}
+	if len(targets) > 0 {
+		f.DependsOn = &flowv1alpha1.DependsOn{Targets: targets}
+	}
+	return f
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Flows      []flowv1alpha1.Flow
+		ExpectErrs []string
+	}{
+		{
+			Name:  "no flows",
+			Flows: nil,
+		},
+		{
+			Name:  "valid dag",
+			Flows: []flowv1alpha1.Flow{flow("a"), flow("b", "a"), flow("c", "a", "b"), flow("d", "b", "c")},
+		},
+		{
+			Name:       "undefined dependency",
+			Flows:      []flowv1alpha1.Flow{flow("a"), flow("b", "a", "x")},
+			ExpectErrs: []string{"vertex is not defined: x, depended on by flow b"},
+		},
+		{
+			Name:       "self dependency",
+			Flows:      []flowv1alpha1.Flow{flow("a", "a")},
+			ExpectErrs: []string{"dependency cycle: a -> a"},
+		},
+		{
+			Name:       "cycle",
+			Flows:      []flowv1alpha1.Flow{flow("a", "c"), flow("b", "a"), flow("c", "b"), flow("d", "c")},
+			ExpectErrs: []string{"dependency cycle: a -> c -> b -> a"},
+		},
+		{
+			Name:  "undefined dependency and cycles",
+			Flows: []flowv1alpha1.Flow{flow("a", "b"), flow("b", "a", "y"), flow("c", "d"), flow("d", "c")},
+			ExpectErrs: []string{
+				"vertex is not defined: y, depended on by flow b",
+				"dependency cycle: a -> b -> a",
+				"dependency cycle: c -> d -> c",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+			for _, err := range Validate(testCase.Flows) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, testCase.ExpectErrs) {
+				t.Errorf("expected errors %v, got %v", testCase.ExpectErrs, got)
+			}
+		})
+	}
+}
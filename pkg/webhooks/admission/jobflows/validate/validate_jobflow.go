@@ -26,13 +26,13 @@ import (
 	"k8s.io/klog/v2"
 
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+	"volcano.sh/volcano/pkg/webhooks/admission/jobflows/dag"
 	"volcano.sh/volcano/pkg/webhooks/router"
 	"volcano.sh/volcano/pkg/webhooks/schema"
 	"volcano.sh/volcano/pkg/webhooks/util"
 )
 
 var (
-	VertexNotDefinedError      = errors.New("vertex is not defined")
 	FlowNotDAGError            = errors.New("jobflow Flow is not DAG")
 	OperationNotCreateOrUpdate = errors.New("expect operation to be 'CREATE' or 'UPDATE'")
 )
@@ -92,31 +92,15 @@ func AdmitJobFlows(ar admissionv1.AdmissionReview) *admissionv1.AdmissionRespons
 }
 
 func validateJobFlowDAG(jobflow *flowv1alpha1.JobFlow, reviewResponse *admissionv1.AdmissionResponse) string {
-	var msg string
-	graphMap := make(map[string][]string, len(jobflow.Spec.Flows))
-	for _, flow := range jobflow.Spec.Flows {
-		if flow.DependsOn != nil && len(flow.DependsOn.Targets) > 0 {
-			graphMap[flow.Name] = flow.DependsOn.Targets
-		} else {
-			graphMap[flow.Name] = []string{}
-		}
-	}
-
-	vetexs, err := LoadVertexs(graphMap)
-
-	if err != nil {
-		msg = FlowNotDAGError.Error() + ": " + err.Error()
-		if msg != "" {
-			reviewResponse.Allowed = false
-		}
-		return msg
+	errs := dag.Validate(jobflow.Spec.Flows)
+	if len(errs) == 0 {
+		return ""
 	}
 
-	if !IsDAG(vetexs) {
-		msg = FlowNotDAGError.Error()
-		if msg != "" {
-			reviewResponse.Allowed = false
-		}
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
 	}
-	return msg
+	reviewResponse.Allowed = false
+	return FlowNotDAGError.Error() + ": " + strings.Join(msgs, "; ")
 }
@@ -170,7 +170,7 @@ func TestValidateJobFlowCreate(t *testing.T) {
 				},
 			},
 			reviewResponse: admissionv1.AdmissionResponse{Allowed: false},
-			ret:            "jobflow Flow is not DAG: vertex is not defined: a",
+			ret:            "jobflow Flow is not DAG: vertex is not defined: a, depended on by flow b; vertex is not defined: a, depended on by flow c",
 			ExpectErr:      true,
 		},
 		// 	jobflow flows not dag
@@ -218,7 +218,7 @@ func TestValidateJobFlowCreate(t *testing.T) {
 				},
 			},
 			reviewResponse: admissionv1.AdmissionResponse{Allowed: false},
-			ret:            "jobflow Flow is not DAG",
+			ret:            "jobflow Flow is not DAG: dependency cycle: a -> b -> a",
 			ExpectErr:      true,
 		},
 		// 	jobflow flows with muti c
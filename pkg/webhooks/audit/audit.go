@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit writes the admission decisions of volcano-admission as JSON lines.
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/klog/v2"
+)
+
+// Decision is an admission decision.
+type Decision struct {
+	Time        time.Time `json:"time"`
+	UID         string    `json:"uid"`
+	Resource    string    `json:"resource"`
+	SubResource string    `json:"subResource,omitempty"`
+	Namespace   string    `json:"namespace,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Operation   string    `json:"operation"`
+	User        string    `json:"user,omitempty"`
+	// Rule is the admission service which made the decision, e.g. /jobs/validate.
+	Rule string `json:"rule"`
+	// Backend is the backend enforcing the rule: webhook, vap, or both when ValidatingAdmissionPolicies
+	// are evaluated in shadow of the webhook. It is always webhook for mutating rules.
+	Backend string `json:"backend"`
+	Allowed bool   `json:"allowed"`
+	Patched bool   `json:"patched,omitempty"`
+	Message string `json:"message,omitempty"`
+	// LatencySeconds is the time the admission service took to make the decision.
+	LatencySeconds float64 `json:"latencySeconds"`
+}
+
+// Logger writes admission decisions to a log, one JSON object per line.
+type Logger struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewLogger creates a logger writing to the file at path, which is rotated once it grows beyond
+// maxSize megabytes and of which at most maxBackups rotated files are kept. If path is "-",
+// decisions are written to stdout.
+func NewLogger(path string, maxSize, maxBackups int) (*Logger, error) {
+	if path == "-" {
+		return &Logger{writer: os.Stdout}, nil
+	}
+
+	writer, err := newRotatingFile(path, int64(maxSize)*1024*1024, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &Logger{writer: writer}, nil
+}
+
+// Log writes the decision to the log.
+func (l *Logger) Log(decision *Decision) {
+	data, err := json.Marshal(decision)
+	if err != nil {
+		klog.Errorf("Failed to marshal admission decision: %v", err)
+		return
+	}
+	data = append(data, '\n')
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, err := l.writer.Write(data); err != nil {
+		klog.Errorf("Failed to write admission decision: %v", err)
+	}
+}
+
+// Audit wraps the admit function of the rule, logging every decision it makes.
+func (l *Logger) Audit(rule, backend string, admit func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	return func(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		start := time.Now()
+		response := admit(ar)
+		if ar.Request != nil {
+			l.Log(newDecision(ar.Request, response, rule, backend, start))
+		}
+		return response
+	}
+}
+
+func newDecision(request *admissionv1.AdmissionRequest, response *admissionv1.AdmissionResponse, rule, backend string, start time.Time) *Decision {
+	decision := &Decision{
+		Time:           start,
+		UID:            string(request.UID),
+		Resource:       request.Resource.Resource,
+		SubResource:    request.SubResource,
+		Namespace:      request.Namespace,
+		Name:           request.Name,
+		Operation:      string(request.Operation),
+		User:           request.UserInfo.Username,
+		Rule:           rule,
+		Backend:        backend,
+		LatencySeconds: time.Since(start).Seconds(),
+	}
+	if response != nil {
+		decision.Allowed = response.Allowed
+		decision.Patched = len(response.Patch) != 0
+		if response.Result != nil {
+			decision.Message = response.Result.Message
+		}
+	}
+	return decision
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAudit(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := &Logger{writer: buffer}
+
+	request := &admissionv1.AdmissionRequest{
+		UID:       "uid",
+		Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs"},
+		Name:      "job",
+		Namespace: "default",
+		Operation: admissionv1.Create,
+		UserInfo:  authenticationv1.UserInfo{Username: "user"},
+	}
+	admit := logger.Audit("/jobs/validate", "webhook", func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &metav1.Status{Message: "job 'minAvailable' must be >= 0"},
+		}
+	})
+	admit(admissionv1.AdmissionReview{Request: request})
+
+	decision := &Decision{}
+	if err := json.Unmarshal(buffer.Bytes(), decision); err != nil {
+		t.Fatalf("failed to unmarshal decision: %v", err)
+	}
+	expected := Decision{
+		Time:           decision.Time,
+		UID:            "uid",
+		Resource:       "jobs",
+		Namespace:      "default",
+		Name:           "job",
+		Operation:      "CREATE",
+		User:           "user",
+		Rule:           "/jobs/validate",
+		Backend:        "webhook",
+		Allowed:        false,
+		Message:        "job 'minAvailable' must be >= 0",
+		LatencySeconds: decision.LatencySeconds,
+	}
+	if *decision != expected {
+		t.Errorf("expected decision %+v, got %+v", expected, *decision)
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	f, err := newRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatalf("failed to create rotating file: %v", err)
+	}
+
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		if _, err := f.Write([]byte(line)); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+
+	expected := map[string]string{
+		path:        "dddddddd\n",
+		path + ".1": "cccccccc\n",
+		path + ".2": "bbbbbbbb\n",
+	}
+	for file, content := range expected {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		if string(data) != content {
+			t.Errorf("expected %s to contain %q, got %q", file, content, string(data))
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected at most 2 backups, got %v", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if len(names) != 3 {
+		t.Errorf("expected 3 files, got %s", strings.Join(names, ","))
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package corpus records the admission requests of Volcano resources as AdmissionReview manifests,
+// the corpus format replayed by vcctl policy impact, and anonymizes and minimizes recorded corpora
+// so that they can be committed as test data.
+package corpus
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+)
+
+const (
+	volcanoGroupSuffix = "volcano.sh"
+
+	// queueSize is the number of requests waiting to be written, further requests are dropped.
+	queueSize = 100
+
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Recorder writes the admission requests of Volcano resources to a directory, one AdmissionReview
+// manifest per request together with the response of the webhook. Requests are written in the
+// background, so that recording never delays the admission of a request.
+type Recorder struct {
+	dir string
+	max int
+
+	queue chan *admissionv1.AdmissionReview
+	// recorded is only accessed by the writer.
+	recorded int
+}
+
+// NewRecorder creates a recorder writing to dir, which stops recording once dir holds max manifests.
+func NewRecorder(dir string, max int) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{dir: dir, max: max, queue: make(chan *admissionv1.AdmissionReview, queueSize), recorded: len(files)}, nil
+}
+
+// Record wraps the admit function, recording every request it handles for a Volcano resource. The
+// request is dropped if the writer falls behind.
+func (r *Recorder) Record(admit func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	return func(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		response := admit(ar)
+		if ar.Request == nil || !strings.HasSuffix(ar.Request.Resource.Group, volcanoGroupSuffix) {
+			return response
+		}
+
+		// The request is copied, Serve resets its objects once the response is created.
+		review := &admissionv1.AdmissionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+			Request:  ar.Request.DeepCopy(),
+		}
+		if response != nil {
+			review.Response = &admissionv1.AdmissionResponse{
+				UID:     ar.Request.UID,
+				Allowed: response.Allowed,
+				Result:  response.Result,
+			}
+		}
+		select {
+		case r.queue <- review:
+		default:
+			klog.Warningf("Dropped admission request %s, the recorder of %s is behind", ar.Request.UID, r.dir)
+		}
+		return response
+	}
+}
+
+// Run writes the recorded requests until stopCh is closed.
+func (r *Recorder) Run(stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case review := <-r.queue:
+			if err := r.write(review); err != nil {
+				klog.Errorf("Failed to record admission request %s: %v", review.Request.UID, err)
+			}
+		}
+	}
+}
+
+func (r *Recorder) write(review *admissionv1.AdmissionReview) error {
+	if r.recorded >= r.max {
+		return nil
+	}
+
+	request, err := sanitize(review.Request)
+	if err != nil {
+		return err
+	}
+	review = &admissionv1.AdmissionReview{TypeMeta: review.TypeMeta, Request: request, Response: review.Response}
+	if err := Write(r.dir, review); err != nil {
+		return err
+	}
+	r.recorded++
+	if r.recorded == r.max {
+		klog.Warningf("Recorded %d admission requests to %s, recording is stopped", r.max, r.dir)
+	}
+	return nil
+}
+
+// sanitize returns a copy of the request without the user who made it, and without the managed
+// fields and last applied configuration of its objects.
+func sanitize(request *admissionv1.AdmissionRequest) (*admissionv1.AdmissionRequest, error) {
+	request = request.DeepCopy()
+	request.UserInfo = authenticationv1.UserInfo{}
+
+	var err error
+	if request.Object, err = sanitizeObject(request.Object); err != nil {
+		return nil, err
+	}
+	if request.OldObject, err = sanitizeObject(request.OldObject); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func sanitizeObject(raw runtime.RawExtension) (runtime.RawExtension, error) {
+	if len(raw.Raw) == 0 {
+		return raw, nil
+	}
+
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(raw.Raw, &object); err != nil {
+		return raw, err
+	}
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "managedFields")
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, lastAppliedConfigAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return raw, err
+	}
+	return runtime.RawExtension{Raw: data}, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package corpus
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+func request(uid, group, resource string) admissionv1.AdmissionReview {
+	return admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID(uid),
+			Resource:  metav1.GroupVersionResource{Group: group, Version: "v1", Resource: resource},
+			Name:      "job",
+			Namespace: "default",
+			Operation: admissionv1.Create,
+			UserInfo:  authenticationv1.UserInfo{Username: "alice", Groups: []string{"team-a"}},
+			Object: runtime.RawExtension{
+				Raw: []byte(`{"metadata":{"name":"job","managedFields":[{"manager":"kubectl"}],"annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}},"spec":{"minAvailable":1}}`),
+			},
+		},
+	}
+}
+
+func TestRecord(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "corpus")
+	recorder, err := NewRecorder(dir, 2)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	admit := recorder.Record(func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		return &admissionv1.AdmissionResponse{Allowed: false, Result: &metav1.Status{Message: "denied"}}
+	})
+
+	review1 := request("1", "batch.volcano.sh", "jobs")
+	admit(review1)
+	// the objects of the request are reset by Serve once the response is created
+	review1.Request.Object = runtime.RawExtension{}
+	// requests of other groups are not recorded
+	admit(request("2", "", "pods"))
+	admit(request("3", "scheduling.volcano.sh", "queues"))
+	// the recorder is full
+	admit(request("4", "batch.volcano.sh", "jobs"))
+
+	if len(recorder.queue) != 3 {
+		t.Fatalf("expected 3 queued requests, got %d", len(recorder.queue))
+	}
+	stopCh := make(chan struct{})
+	go recorder.Run(stopCh)
+	if err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+		return len(files) == 2 && len(recorder.queue) == 0, err
+	}); err != nil {
+		t.Fatalf("expected the queued requests to be written: %v", err)
+	}
+	close(stopCh)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 recorded requests, got %v", files)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "jobs-create-1.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read recorded request: %v", err)
+	}
+	review := &admissionv1.AdmissionReview{}
+	if err := yaml.Unmarshal(data, review); err != nil {
+		t.Fatalf("failed to unmarshal recorded request: %v", err)
+	}
+	if review.Kind != "AdmissionReview" || review.Request == nil || review.Response == nil {
+		t.Fatalf("expected an AdmissionReview with request and response, got %s", data)
+	}
+	if review.Request.UserInfo.Username != "" || len(review.Request.UserInfo.Groups) != 0 {
+		t.Errorf("expected user info to be removed, got %v", review.Request.UserInfo)
+	}
+	expectedObject := `{"metadata":{"name":"job"},"spec":{"minAvailable":1}}`
+	if string(review.Request.Object.Raw) != expectedObject {
+		t.Errorf("expected object %s, got %s", expectedObject, review.Request.Object.Raw)
+	}
+	if review.Response.UID != "1" || review.Response.Allowed || review.Response.Result.Message != "denied" {
+		t.Errorf("expected the denial to be recorded, got %v", review.Response)
+	}
+
+	// recorded requests count towards the maximum after a restart
+	recorder, err = NewRecorder(dir, 2)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	review5 := request("5", "batch.volcano.sh", "jobs")
+	if err := recorder.write(&review5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "jobs-create-5.yaml")); !os.IsNotExist(err) {
+		t.Errorf("expected no request to be recorded once full, got %v", err)
+	}
+
+	// requests are dropped rather than delaying the admission when the writer is behind
+	admit = recorder.Record(func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	})
+	for i := 0; i <= queueSize; i++ {
+		admit(request(fmt.Sprint(i), "batch.volcano.sh", "jobs"))
+	}
+	if len(recorder.queue) != queueSize {
+		t.Errorf("expected %d queued requests, got %d", queueSize, len(recorder.queue))
+	}
+}
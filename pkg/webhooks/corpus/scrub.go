@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package corpus
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+)
+
+const redacted = "redacted"
+
+// Load loads the AdmissionReviews in the manifests under path, which is either a file or a directory.
+func Load(path string) ([]*admissionv1.AdmissionReview, error) {
+	var reviews []*admissionv1.AdmissionReview
+	err := manifest.Load(path, func(object *unstructured.Unstructured) error {
+		review := &admissionv1.AdmissionReview{}
+		if object.GetKind() == manifest.KindAdmissionReview {
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, review); err != nil {
+				return err
+			}
+		}
+		if review.Request == nil {
+			return fmt.Errorf("found a %s which is not an AdmissionReview with a request", object.GetKind())
+		}
+		reviews = append(reviews, review)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// Write writes the review to dir, named after the resource, operation and UID of its request.
+func Write(dir string, review *admissionv1.AdmissionReview) error {
+	data, err := yaml.Marshal(review)
+	if err != nil {
+		return err
+	}
+	request := review.Request
+	name := fmt.Sprintf("%s-%s-%s.yaml", request.Resource.Resource, strings.ToLower(string(request.Operation)), request.UID)
+	return os.WriteFile(filepath.Join(dir, name), data, 0644)
+}
+
+// Anonymizer scrubs the identifying data of recorded requests, so that they can be committed.
+type Anonymizer struct {
+	salt string
+}
+
+// NewAnonymizer creates an anonymizer. Names are replaced by a hash salted with salt, so that a
+// name is replaced consistently across the corpus while the original cannot be guessed.
+func NewAnonymizer(salt string) *Anonymizer {
+	return &Anonymizer{salt: salt}
+}
+
+// Anonymize scrubs the request of the review in place: the user info is removed, names, namespaces
+// and label values are hashed, annotations are removed, and container images and env values are
+// redacted. The message of the response is rewritten with the hashed name and namespace.
+func (a *Anonymizer) Anonymize(review *admissionv1.AdmissionReview) error {
+	request := review.Request
+	name, namespace := request.Name, request.Namespace
+	request.UserInfo = authenticationv1.UserInfo{}
+
+	var err error
+	if request.Object, err = a.anonymizeObject(request.Object); err != nil {
+		return err
+	}
+	if request.OldObject, err = a.anonymizeObject(request.OldObject); err != nil {
+		return err
+	}
+	request.Name = a.hash(name)
+	request.Namespace = a.hash(namespace)
+
+	if review.Response != nil && review.Response.Result != nil {
+		message := review.Response.Result.Message
+		if name != "" {
+			message = strings.ReplaceAll(message, name, request.Name)
+		}
+		if namespace != "" {
+			message = strings.ReplaceAll(message, namespace, request.Namespace)
+		}
+		review.Response.Result.Message = message
+	}
+	return nil
+}
+
+func (a *Anonymizer) hash(value string) string {
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(a.salt + value))
+	return "anon-" + hex.EncodeToString(sum[:])[:10]
+}
+
+func (a *Anonymizer) anonymizeObject(raw runtime.RawExtension) (runtime.RawExtension, error) {
+	if len(raw.Raw) == 0 {
+		return raw, nil
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(raw.Raw, &object); err != nil {
+		return raw, err
+	}
+
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		for _, key := range []string{"name", "namespace", "generateName"} {
+			if value, ok := metadata[key].(string); ok {
+				metadata[key] = a.hash(value)
+			}
+		}
+		for _, key := range []string{"annotations", "uid", "resourceVersion", "managedFields", "ownerReferences"} {
+			delete(metadata, key)
+		}
+	}
+	a.redact(object)
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return raw, err
+	}
+	return runtime.RawExtension{Raw: data}, nil
+}
+
+// redact replaces the images and the env values found anywhere in the value, and hashes the values
+// of labels, e.g. the ones of pod templates.
+func (a *Anonymizer) redact(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch key {
+			case "labels":
+				if labels, ok := field.(map[string]interface{}); ok {
+					for label, labelValue := range labels {
+						if s, ok := labelValue.(string); ok {
+							labels[label] = a.hash(s)
+						}
+					}
+					continue
+				}
+			case "image":
+				if _, ok := field.(string); ok {
+					v[key] = redacted
+					continue
+				}
+			case "env":
+				if envs, ok := field.([]interface{}); ok {
+					for _, env := range envs {
+						if e, ok := env.(map[string]interface{}); ok {
+							if _, ok := e["value"].(string); ok {
+								e["value"] = redacted
+							}
+						}
+					}
+				}
+			}
+			a.redact(field)
+		}
+	case []interface{}:
+		for _, item := range v {
+			a.redact(item)
+		}
+	}
+}
+
+// Minimize returns the first review of each distinct Signature, in order.
+func Minimize(reviews []*admissionv1.AdmissionReview) ([]*admissionv1.AdmissionReview, error) {
+	seen := map[string]bool{}
+	var minimized []*admissionv1.AdmissionReview
+	for _, review := range reviews {
+		signature, err := Signature(review)
+		if err != nil {
+			return nil, err
+		}
+		if seen[signature] {
+			continue
+		}
+		seen[signature] = true
+		minimized = append(minimized, review)
+	}
+	return minimized, nil
+}
+
+// Signature returns the structure of the review: the resource and operation of the request, the
+// verdict and message of the response, and the set of field paths of its objects with the type of
+// their values. The name and namespace of the request are replaced in the message, so that the
+// same denial of different objects shares a signature while denials by different policies or
+// validations do not. Reviews which only differ in the values of their fields share a signature.
+func Signature(review *admissionv1.AdmissionReview) (string, error) {
+	request := review.Request
+	allowed := review.Response == nil || review.Response.Allowed
+	var message string
+	if review.Response != nil && review.Response.Result != nil {
+		message = review.Response.Result.Message
+		if request.Name != "" {
+			message = strings.ReplaceAll(message, request.Name, "<name>")
+		}
+		if request.Namespace != "" {
+			message = strings.ReplaceAll(message, request.Namespace, "<namespace>")
+		}
+	}
+	parts := []string{fmt.Sprintf("%s/%s %s allowed=%v message=%q", request.Resource.Resource, request.SubResource, request.Operation, allowed, message)}
+
+	for _, o := range []struct {
+		prefix string
+		raw    runtime.RawExtension
+	}{{"object", request.Object}, {"oldObject", request.OldObject}} {
+		if len(o.raw.Raw) == 0 {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(o.raw.Raw, &value); err != nil {
+			return "", err
+		}
+		paths := map[string]bool{}
+		collectPaths(o.prefix, value, paths)
+		for path := range paths {
+			parts = append(parts, path)
+		}
+	}
+	sort.Strings(parts[1:])
+	return strings.Join(parts, "\n"), nil
+}
+
+func collectPaths(path string, value interface{}, paths map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		paths[path+":object"] = true
+		for key, field := range v {
+			collectPaths(path+"."+key, field, paths)
+		}
+	case []interface{}:
+		paths[path+":list"] = true
+		for _, item := range v {
+			collectPaths(path+"[]", item, paths)
+		}
+	case string:
+		paths[path+":string"] = true
+	case float64:
+		paths[path+":number"] = true
+	case bool:
+		paths[path+":bool"] = true
+	case nil:
+		paths[path+":null"] = true
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package corpus
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func jobReview(uid, name string, minAvailable int, allowed bool) *admissionv1.AdmissionReview {
+	object := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        name,
+			"namespace":   "team-a",
+			"uid":         "0a1b",
+			"annotations": map[string]interface{}{"owner": "alice@example.com"},
+			"labels":      map[string]interface{}{"team": "team-a"},
+		},
+		"spec": map[string]interface{}{
+			"minAvailable": minAvailable,
+			"tasks": []interface{}{
+				map[string]interface{}{
+					"name": "worker",
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"labels": map[string]interface{}{"app": "trainer"},
+						},
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"image": "registry.example.com/team-a/trainer:v1",
+									"env": []interface{}{
+										map[string]interface{}{"name": "TOKEN", "value": "secret"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	raw, _ := json.Marshal(object)
+	return &admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID(uid),
+			Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs"},
+			Name:      name,
+			Namespace: "team-a",
+			Operation: admissionv1.Create,
+			UserInfo: authenticationv1.UserInfo{
+				Username: "alice@example.com",
+				Groups:   []string{"team-a"},
+				Extra:    map[string]authenticationv1.ExtraValue{"scopes": {"admin"}},
+			},
+			Object: runtime.RawExtension{Raw: raw},
+		},
+		Response: &admissionv1.AdmissionResponse{
+			Allowed: allowed,
+			Result:  &metav1.Status{Message: "job " + name + " in team-a is invalid"},
+		},
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	review := jobReview("1", "trainer", 1, false)
+	anonymizer := NewAnonymizer("salt")
+	if err := anonymizer.Anonymize(review); err != nil {
+		t.Fatalf("failed to anonymize: %v", err)
+	}
+
+	name, namespace := anonymizer.hash("trainer"), anonymizer.hash("team-a")
+	if review.Request.Name != name || review.Request.Namespace != namespace {
+		t.Errorf("expected request of %s/%s, got %s/%s", namespace, name, review.Request.Namespace, review.Request.Name)
+	}
+	if expected := "job " + name + " in " + namespace + " is invalid"; review.Response.Result.Message != expected {
+		t.Errorf("expected message %q, got %q", expected, review.Response.Result.Message)
+	}
+
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(review.Request.Object.Raw, &object); err != nil {
+		t.Fatal(err)
+	}
+	expectedMetadata := map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+		"labels":    map[string]interface{}{"team": namespace},
+	}
+	if !reflect.DeepEqual(object["metadata"], expectedMetadata) {
+		t.Errorf("expected metadata %v, got %v", expectedMetadata, object["metadata"])
+	}
+	if !reflect.DeepEqual(review.Request.UserInfo, authenticationv1.UserInfo{}) {
+		t.Errorf("expected user info to be removed, got %v", review.Request.UserInfo)
+	}
+	template := object["spec"].(map[string]interface{})["tasks"].([]interface{})[0].(map[string]interface{})["template"].(map[string]interface{})
+	expectedTemplateMetadata := map[string]interface{}{"labels": map[string]interface{}{"app": anonymizer.hash("trainer")}}
+	if !reflect.DeepEqual(template["metadata"], expectedTemplateMetadata) {
+		t.Errorf("expected pod template metadata %v, got %v", expectedTemplateMetadata, template["metadata"])
+	}
+	container := template["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+	expectedContainer := map[string]interface{}{
+		"image": redacted,
+		"env":   []interface{}{map[string]interface{}{"name": "TOKEN", "value": redacted}},
+	}
+	if !reflect.DeepEqual(container, expectedContainer) {
+		t.Errorf("expected container %v, got %v", expectedContainer, container)
+	}
+
+	// the same name is hashed the same way, a different salt hashes it differently
+	if anonymizer.hash("trainer") != name || NewAnonymizer("other").hash("trainer") == name {
+		t.Errorf("expected hashes to be consistent per salt")
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	deniedByPolicy := jobReview("5", "job-e", -3, false)
+	deniedByPolicy.Response.Result.Message = "ValidatingAdmissionPolicy 'job-min-available' denied request"
+	reviews := []*admissionv1.AdmissionReview{
+		jobReview("1", "job-a", 1, true),
+		// only values differ
+		jobReview("2", "job-b", 2, true),
+		// the verdict differs
+		jobReview("3", "job-c", -1, false),
+		// only values differ, the message only differs in the name
+		jobReview("4", "job-d", -2, false),
+		// the message differs
+		deniedByPolicy,
+	}
+	minimized, err := Minimize(reviews)
+	if err != nil {
+		t.Fatalf("failed to minimize: %v", err)
+	}
+	var uids []types.UID
+	for _, review := range minimized {
+		uids = append(uids, review.Request.UID)
+	}
+	if expected := []types.UID{"1", "3", "5"}; !reflect.DeepEqual(uids, expected) {
+		t.Errorf("expected reviews %v, got %v", expected, uids)
+	}
+}
+
+func TestWriteAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	for _, review := range []*admissionv1.AdmissionReview{jobReview("1", "job-a", 1, true), jobReview("2", "job-b", -1, false)} {
+		if err := Write(dir, review); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+
+	reviews, err := Load(dir)
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Fatalf("expected 2 reviews, got %d", len(reviews))
+	}
+	if reviews[0].Request.Name != "job-a" || reviews[1].Response.Allowed {
+		t.Errorf("unexpected reviews loaded from %s", filepath.Join(dir, "*.yaml"))
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(reviews[0].Request.Object.Raw, &object); err != nil {
+		t.Fatalf("failed to decode the loaded object: %v", err)
+	}
+	if minAvailable := object["spec"].(map[string]interface{})["minAvailable"]; minAvailable != float64(1) {
+		t.Errorf("expected the object to be loaded, got %s", reviews[0].Request.Object.Raw)
+	}
+}
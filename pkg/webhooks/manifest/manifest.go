@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest loads the objects of the YAML and JSON manifests holding admission policies and
+// recorded admission requests.
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// KindValidatingAdmissionPolicy is the kind of ValidatingAdmissionPolicy objects.
+	KindValidatingAdmissionPolicy = "ValidatingAdmissionPolicy"
+	// KindValidatingAdmissionPolicyBinding is the kind of ValidatingAdmissionPolicyBinding objects.
+	KindValidatingAdmissionPolicyBinding = "ValidatingAdmissionPolicyBinding"
+	// KindAdmissionReview is the kind of AdmissionReview objects.
+	KindAdmissionReview = "AdmissionReview"
+)
+
+// Files returns path if it is a file, or the .yaml, .yml and .json files under it if it is a directory.
+func Files(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(file string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml", ".json":
+			if !d.IsDir() {
+				files = append(files, file)
+			}
+		}
+		return nil
+	})
+	return files, err
+}
+
+// Load calls load with every object of the manifests under path, which is either a file or a
+// directory of .yaml, .yml and .json files, in order. Empty documents are skipped.
+func Load(path string, load func(object *unstructured.Unstructured) error) error {
+	files, err := Files(path)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := loadFile(file, load); err != nil {
+			return fmt.Errorf("failed to load %s: %v", file, err)
+		}
+	}
+	return nil
+}
+
+func loadFile(file string, load func(object *unstructured.Unstructured) error) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if err := load(object); err != nil {
+			return err
+		}
+	}
+}
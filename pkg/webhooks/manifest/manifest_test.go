@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"policies.yaml": `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-min-available
+---
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: job-min-available
+`,
+		"nested/review.json": `{"apiVersion":"admission.k8s.io/v1","kind":"AdmissionReview"}`,
+		"README.md":          "not a manifest",
+	}
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var kinds []string
+	if err := Load(dir, func(object *unstructured.Unstructured) error {
+		kinds = append(kinds, object.GetKind())
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+	expected := []string{KindAdmissionReview, KindValidatingAdmissionPolicy, KindValidatingAdmissionPolicyBinding}
+	if !reflect.DeepEqual(kinds, expected) {
+		t.Errorf("expected kinds %v, got %v", expected, kinds)
+	}
+
+	// errors of the callback are returned with the file
+	err := Load(filepath.Join(dir, "policies.yaml"), func(object *unstructured.Unstructured) error {
+		return fmt.Errorf("unexpected %s", object.GetKind())
+	})
+	if expectedErr := fmt.Sprintf("failed to load %s: unexpected %s", filepath.Join(dir, "policies.yaml"), KindValidatingAdmissionPolicy); err == nil || err.Error() != expectedErr {
+		t.Errorf("expected error %q, got %v", expectedErr, err)
+	}
+
+	if err := Load(filepath.Join(dir, "missing.yaml"), func(*unstructured.Unstructured) error { return nil }); err == nil {
+		t.Errorf("expected error for missing path")
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// VolcanoSubSystemName - subsystem name in prometheus used by volcano
+const VolcanoSubSystemName = "volcano"
+
+const (
+	// VerdictAllowed means the admission request is allowed.
+	VerdictAllowed = "allowed"
+	// VerdictDenied means the admission request is denied.
+	VerdictDenied = "denied"
+)
+
+const (
+	// ShadowResultMatch means the webhook and the shadow policies returned the same verdict.
+	ShadowResultMatch = "match"
+	// ShadowResultDivergence means the webhook and the shadow policies returned different verdicts.
+	ShadowResultDivergence = "divergence"
+	// ShadowResultError means the shadow policies could not be evaluated.
+	ShadowResultError = "error"
+)
+
+var (
+	admissionRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "admission_requests_total",
+			Help:      "The number of admission requests handled by each admission webhook, by verdict",
+		}, []string{"resource", "operation", "webhook", "verdict"},
+	)
+
+	admissionLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "admission_duration_seconds",
+			Help:      "The time each admission webhook takes to handle an admission request, by verdict",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"resource", "operation", "webhook", "verdict"},
+	)
+
+	shadowEvaluations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "admission_shadow_evaluations_total",
+			Help:      "The number of admission requests evaluated by the shadow policies, by result",
+		}, []string{"resource", "operation", "result"},
+	)
+)
+
+// UpdateAdmissionRequest records an admission request handled by the webhook and the time it took
+func UpdateAdmissionRequest(resource, operation, webhook, verdict string, duration time.Duration) {
+	admissionRequests.WithLabelValues(resource, operation, webhook, verdict).Inc()
+	admissionLatency.WithLabelValues(resource, operation, webhook, verdict).Observe(duration.Seconds())
+}
+
+// UpdateShadowEvaluation records the result of a shadow evaluation
+func UpdateShadowEvaluation(resource, operation, result string) {
+	shadowEvaluations.WithLabelValues(resource, operation, result).Inc()
+}
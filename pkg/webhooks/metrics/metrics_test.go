@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestUpdateAdmissionRequest(t *testing.T) {
+	UpdateAdmissionRequest("jobs", "CREATE", "/jobs/validate", VerdictDenied, 10*time.Millisecond)
+	UpdateAdmissionRequest("jobs", "CREATE", "/jobs/validate", VerdictDenied, 20*time.Millisecond)
+	UpdateAdmissionRequest("jobs", "CREATE", "/jobs/validate", VerdictAllowed, time.Millisecond)
+
+	if count := testutil.ToFloat64(admissionRequests.WithLabelValues("jobs", "CREATE", "/jobs/validate", VerdictDenied)); count != 2 {
+		t.Errorf("expected 2 denied requests, got %v", count)
+	}
+	if count := testutil.ToFloat64(admissionRequests.WithLabelValues("jobs", "CREATE", "/jobs/validate", VerdictAllowed)); count != 1 {
+		t.Errorf("expected 1 allowed request, got %v", count)
+	}
+	if count := testutil.CollectAndCount(admissionLatency, "volcano_admission_duration_seconds"); count != 2 {
+		t.Errorf("expected 2 latency series, got %v", count)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight checks whether a cluster is able to enforce Volcano admission with
+// ValidatingAdmissionPolicies instead of the validating webhooks, before any change is made.
+package preflight
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/volcano/pkg/cel"
+)
+
+const admissionRegistrationGroup = "admissionregistration.k8s.io"
+
+// validatingAdmissionPolicyMinVersion is the first Kubernetes version serving ValidatingAdmissionPolicy as GA.
+var validatingAdmissionPolicyMinVersion = version.MajorMinor(1, 30)
+
+// celLibraries are probe expressions of the CEL libraries which policies may use.
+var celLibraries = []struct {
+	name  string
+	probe string
+}{
+	{name: "quantity", probe: "quantity('1Gi').isGreaterThan(quantity('1Mi'))"},
+	{name: "sets", probe: "sets.contains([1, 2], [1])"},
+	{name: "ip", probe: "isIP('10.0.0.1')"},
+	{name: "cidr", probe: "cidr('10.0.0.0/8').containsIP(ip('10.0.0.1'))"},
+	{name: "format", probe: "!format.dns1123Label().validate('volcano').hasValue()"},
+	{name: "semver", probe: "isSemver('1.0.0')"},
+}
+
+// Check is the result of a single pre-flight check.
+type Check struct {
+	Name string
+	// Required checks must pass for the cluster to be ready, others only warn.
+	Required bool
+	Passed   bool
+	Message  string
+}
+
+// Report is the result of all the pre-flight checks.
+type Report struct {
+	ServerVersion string
+	Checks        []Check
+}
+
+// Ready returns whether all the required checks passed.
+func (r *Report) Ready() bool {
+	for _, c := range r.Checks {
+		if c.Required && !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Options configures the pre-flight checks.
+type Options struct {
+	// ParamResources are the resources the params of the policies are read from.
+	ParamResources []schema.GroupResource
+}
+
+// Run checks the apiserver version, the admission policy APIs it serves, the permissions of the
+// current user to install policies and read their params, and the CEL libraries available to
+// new expressions. Failing to reach the apiserver is returned as an error.
+func Run(ctx context.Context, client kubernetes.Interface, opts Options) (*Report, error) {
+	info, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get apiserver version: %v", err)
+	}
+	serverVersion, err := version.ParseGeneric(info.GitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse apiserver version %s: %v", info.GitVersion, err)
+	}
+
+	report := &Report{ServerVersion: info.GitVersion}
+	report.Checks = append(report.Checks, checkVersion(serverVersion))
+
+	apiChecks, err := checkAPIs(client)
+	if err != nil {
+		return nil, err
+	}
+	report.Checks = append(report.Checks, apiChecks...)
+
+	rbacChecks, err := checkRBAC(ctx, client, opts.ParamResources)
+	if err != nil {
+		return nil, err
+	}
+	report.Checks = append(report.Checks, rbacChecks...)
+
+	report.Checks = append(report.Checks, checkCELLibraries(serverVersion)...)
+	return report, nil
+}
+
+func checkVersion(serverVersion *version.Version) Check {
+	c := Check{Name: "apiserver version", Required: true, Passed: true}
+	if serverVersion.LessThan(validatingAdmissionPolicyMinVersion) {
+		c.Passed = false
+		c.Message = fmt.Sprintf("ValidatingAdmissionPolicy is GA since %s, got %s", validatingAdmissionPolicyMinVersion, serverVersion)
+	}
+	return c
+}
+
+func checkAPIs(client kubernetes.Interface) ([]Check, error) {
+	validating := Check{Name: "ValidatingAdmissionPolicy API", Required: true}
+	served, err := servesResources(client, admissionRegistrationGroup+"/v1", "validatingadmissionpolicies", "validatingadmissionpolicybindings")
+	if err != nil {
+		return nil, err
+	}
+	if served {
+		validating.Passed = true
+	} else {
+		validating.Message = fmt.Sprintf("%s/v1 does not serve validatingadmissionpolicies, check the runtime config of the apiserver", admissionRegistrationGroup)
+	}
+
+	// MutatingAdmissionPolicy is only needed to replace the mutating webhooks.
+	mutating := Check{Name: "MutatingAdmissionPolicy API"}
+	for _, v := range []string{"v1beta1", "v1alpha1"} {
+		served, err := servesResources(client, admissionRegistrationGroup+"/"+v, "mutatingadmissionpolicies", "mutatingadmissionpolicybindings")
+		if err != nil {
+			return nil, err
+		}
+		if served {
+			mutating.Passed = true
+			mutating.Message = fmt.Sprintf("served as %s", v)
+			break
+		}
+	}
+	if !mutating.Passed {
+		mutating.Message = "not served, enable the MutatingAdmissionPolicy feature gate and API to replace the mutating webhooks"
+	}
+
+	return []Check{validating, mutating}, nil
+}
+
+func servesResources(client kubernetes.Interface, groupVersion string, resources ...string) (bool, error) {
+	list, err := client.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to discover %s: %v", groupVersion, err)
+	}
+
+	served := map[string]bool{}
+	for _, r := range list.APIResources {
+		served[r.Name] = true
+	}
+	for _, r := range resources {
+		if !served[r] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func checkRBAC(ctx context.Context, client kubernetes.Interface, paramResources []schema.GroupResource) ([]Check, error) {
+	var checks []Check
+	for _, resource := range []string{"validatingadmissionpolicies", "validatingadmissionpolicybindings"} {
+		c, err := checkAccess(ctx, client, authorizationv1.ResourceAttributes{
+			Verb:     "create",
+			Group:    admissionRegistrationGroup,
+			Resource: resource,
+		}, true)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, c)
+	}
+	for _, resource := range paramResources {
+		for _, verb := range []string{"get", "list"} {
+			c, err := checkAccess(ctx, client, authorizationv1.ResourceAttributes{
+				Verb:     verb,
+				Group:    resource.Group,
+				Resource: resource.Resource,
+			}, false)
+			if err != nil {
+				return nil, err
+			}
+			checks = append(checks, c)
+		}
+	}
+	return checks, nil
+}
+
+func checkAccess(ctx context.Context, client kubernetes.Interface, attributes authorizationv1.ResourceAttributes, required bool) (Check, error) {
+	resource := attributes.Resource
+	if attributes.Group != "" {
+		resource = resource + "." + attributes.Group
+	}
+	c := Check{Name: fmt.Sprintf("RBAC %s %s", attributes.Verb, resource), Required: required}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+	}
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return c, fmt.Errorf("failed to review access to %s %s: %v", attributes.Verb, resource, err)
+	}
+	c.Passed = review.Status.Allowed
+	if !c.Passed {
+		c.Message = "denied"
+		if review.Status.Reason != "" {
+			c.Message = review.Status.Reason
+		}
+	}
+	return c, nil
+}
+
+// checkCELLibraries compiles the probe of each library at the compatibility version the apiserver
+// uses for new expressions, which is one minor version behind the apiserver to allow rollback.
+func checkCELLibraries(serverVersion *version.Version) []Check {
+	minor := serverVersion.Minor()
+	if minor > 0 {
+		minor--
+	}
+	compatibilityVersion := version.MajorMinor(serverVersion.Major(), minor)
+	compiler := cel.NewCompilerForVersion(compatibilityVersion)
+
+	checks := make([]Check, 0, len(celLibraries))
+	for _, library := range celLibraries {
+		c := Check{Name: fmt.Sprintf("CEL library %s", library.name), Passed: true}
+		if _, err := compiler.Compile([]cel.Validation{{Expression: library.probe}}, false); err != nil {
+			c.Passed = false
+			c.Message = fmt.Sprintf("not available to new expressions at compatibility version %s", compatibilityVersion)
+		}
+		checks = append(checks, c)
+	}
+	return checks
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	versioninfo "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newClient(gitVersion string, resources []*metav1.APIResourceList, allowed func(*authorizationv1.ResourceAttributes) bool) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	discovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.FakedServerVersion = &versioninfo.Info{GitVersion: gitVersion}
+	discovery.Resources = resources
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed(review.Spec.ResourceAttributes)
+		return true, review, nil
+	})
+	return client
+}
+
+func TestRun(t *testing.T) {
+	validatingResources := &metav1.APIResourceList{
+		GroupVersion: "admissionregistration.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "validatingwebhookconfigurations"},
+			{Name: "validatingadmissionpolicies"},
+			{Name: "validatingadmissionpolicybindings"},
+		},
+	}
+	mutatingResources := &metav1.APIResourceList{
+		GroupVersion: "admissionregistration.k8s.io/v1alpha1",
+		APIResources: []metav1.APIResource{
+			{Name: "mutatingadmissionpolicies"},
+			{Name: "mutatingadmissionpolicybindings"},
+		},
+	}
+	allowAll := func(*authorizationv1.ResourceAttributes) bool { return true }
+	denyParams := func(attributes *authorizationv1.ResourceAttributes) bool {
+		return attributes.Group == admissionRegistrationGroup
+	}
+
+	testCases := []struct {
+		Name         string
+		Client       *fake.Clientset
+		ExpectReady  bool
+		ExpectFailed []string
+	}{
+		{
+			Name:        "ready cluster",
+			Client:      newClient("v1.34.0", []*metav1.APIResourceList{validatingResources, mutatingResources}, allowAll),
+			ExpectReady: true,
+		},
+		{
+			Name:         "ready cluster with optional failures",
+			Client:       newClient("v1.31.0", []*metav1.APIResourceList{validatingResources}, denyParams),
+			ExpectReady:  true,
+			ExpectFailed: []string{"MutatingAdmissionPolicy API", "RBAC get configmaps", "RBAC list configmaps", "CEL library format", "CEL library semver"},
+		},
+		{
+			Name:         "old cluster",
+			Client:       newClient("v1.29.4+k3s1", nil, allowAll),
+			ExpectReady:  false,
+			ExpectFailed: []string{"apiserver version", "ValidatingAdmissionPolicy API", "MutatingAdmissionPolicy API", "CEL library sets", "CEL library ip", "CEL library cidr", "CEL library format", "CEL library semver"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			report, err := Run(context.TODO(), testCase.Client, Options{
+				ParamResources: []schema.GroupResource{{Resource: "configmaps"}},
+			})
+			if err != nil {
+				t.Fatalf("failed to run pre-flight checks: %v", err)
+			}
+			if report.Ready() != testCase.ExpectReady {
+				t.Errorf("expected ready %v, got %v", testCase.ExpectReady, report.Ready())
+			}
+
+			var failed []string
+			for _, c := range report.Checks {
+				if !c.Passed {
+					failed = append(failed, c.Name)
+				}
+			}
+			if len(failed) != len(testCase.ExpectFailed) {
+				t.Fatalf("expected failed checks %v, got %v", testCase.ExpectFailed, failed)
+			}
+			for i := range failed {
+				if failed[i] != testCase.ExpectFailed[i] {
+					t.Errorf("expected failed checks %v, got %v", testCase.ExpectFailed, failed)
+					break
+				}
+			}
+		})
+	}
+}
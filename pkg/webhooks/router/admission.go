@@ -21,10 +21,16 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/cmd/webhook-manager/app/options"
+	"volcano.sh/volcano/pkg/webhooks/audit"
+	"volcano.sh/volcano/pkg/webhooks/corpus"
+	"volcano.sh/volcano/pkg/webhooks/metrics"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
 )
 
 type AdmissionHandler func(w http.ResponseWriter, r *http.Request)
@@ -64,3 +70,83 @@ func ForEachAdmission(config *options.Config, handler func(*AdmissionService) er
 	}
 	return nil
 }
+
+// ValidatedResources returns the resources validated by the registered admission services.
+func ValidatedResources() []string {
+	admissionMutex.Lock()
+	defer admissionMutex.Unlock()
+
+	var resources []string
+	for _, service := range admissionMap {
+		if service.ValidatingConfig != nil {
+			resources = append(resources, service.Resource())
+		}
+	}
+	return resources
+}
+
+// HandlerOptions are the optional features wrapping the admit functions of admission services.
+type HandlerOptions struct {
+	// ShadowEvaluator compares the verdicts of validating webhooks with the ones of shadow policies,
+	// for the resources whose validation backend is both.
+	ShadowEvaluator *shadow.Evaluator
+	// AuditLogger logs every admission decision.
+	AuditLogger *audit.Logger
+	// CorpusRecorder records the requests served by validating webhooks, unless their validation is
+	// delegated to ValidatingAdmissionPolicy.
+	CorpusRecorder *corpus.Recorder
+}
+
+// AdmissionHandlerFor returns the handler of the admission service. The validating webhook of a resource
+// whose validation is enforced by ValidatingAdmissionPolicy only, admits every request. The validating
+// webhook of a resource validated by both, is evaluated alongside the shadow policies.
+func AdmissionHandlerFor(config *options.Config, service *AdmissionService, opts HandlerOptions) AdmissionHandler {
+	admit := service.Func
+	backend := options.ValidationBackendWebhook
+	if service.ValidatingConfig != nil {
+		backend = config.ValidationBackend(service.Resource())
+	}
+	if backend == options.ValidationBackendVAP {
+		klog.V(3).Infof("Validation of %s is enforced by ValidatingAdmissionPolicy, webhook '%s' admits all requests.", service.Resource(), service.Path)
+		admit = admitDelegated
+	}
+	admit = instrument(service.Path, admit)
+	if opts.AuditLogger != nil {
+		admit = opts.AuditLogger.Audit(service.Path, backend, admit)
+	}
+	// The requests of delegated resources are not recorded, their verdict is not the one of a webhook.
+	if service.ValidatingConfig != nil && backend != options.ValidationBackendVAP && opts.CorpusRecorder != nil {
+		admit = opts.CorpusRecorder.Record(admit)
+	}
+	if backend == options.ValidationBackendBoth && opts.ShadowEvaluator != nil {
+		klog.V(3).Infof("Evaluate shadow policies for webhook '%s'.", service.Path)
+		admit = opts.ShadowEvaluator.Shadow(admit)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		Serve(w, r, admit)
+	}
+}
+
+// instrument records the metrics of every admission request handled by the admit function of the webhook.
+func instrument(webhook string, admit AdmitFunc) AdmitFunc {
+	return func(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		start := time.Now()
+		response := admit(ar)
+		if ar.Request != nil {
+			verdict := metrics.VerdictDenied
+			if response != nil && response.Allowed {
+				verdict = metrics.VerdictAllowed
+			}
+			metrics.UpdateAdmissionRequest(ar.Request.Resource.Resource, string(ar.Request.Operation), webhook, verdict, time.Since(start))
+		}
+		return response
+	}
+}
+
+func admitDelegated(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	klog.V(4).Infof("Admitting %s of %s, validation is delegated to ValidatingAdmissionPolicy", ar.Request.Operation, ar.Request.Resource.Resource)
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	admissionv1 "k8s.io/api/admission/v1"
+	whv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"volcano.sh/volcano/cmd/webhook-manager/app/options"
+	"volcano.sh/volcano/pkg/webhooks/audit"
+	"volcano.sh/volcano/pkg/webhooks/corpus"
+	"volcano.sh/volcano/pkg/webhooks/shadow"
+)
+
+const allowAllPolicy = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: allow-all
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["*"]
+      apiVersions: ["*"]
+      operations: ["*"]
+      resources: ["*"]
+  validations:
+  - expression: "true"
+`
+
+func denyAll(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: false, Result: &metav1.Status{Message: "denied by webhook"}}
+}
+
+// shadowEvaluations returns the number of shadow evaluations of the resource.
+func shadowEvaluations(t *testing.T, resource string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	var count float64
+	for _, family := range families {
+		if family.GetName() != "volcano_admission_shadow_evaluations_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "resource" && label.GetValue() == resource {
+					count += metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return count
+}
+
+// lastAuditedBackend returns the backend of the last decision in the audit log.
+func lastAuditedBackend(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the audit log: %v", err)
+	}
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	decision := &audit.Decision{}
+	if err := json.Unmarshal(lines[len(lines)-1], decision); err != nil {
+		t.Fatalf("failed to decode the audited decision: %v", err)
+	}
+	return decision.Backend
+}
+
+func serve(t *testing.T, handler AdmissionHandler, resource string) *admissionv1.AdmissionResponse {
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID(resource),
+			Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: resource},
+			Name:      "test",
+			Namespace: "default",
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"test"}}`)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := httptest.NewRequest(http.MethodPost, "/"+resource+"/validate", bytes.NewReader(body))
+	request.Header.Set(CONTENTTYPE, APPLICATIONJSON)
+	recorder := httptest.NewRecorder()
+	handler(recorder, request)
+
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), review); err != nil || review.Response == nil {
+		t.Fatalf("failed to decode the response %s: %v", recorder.Body.String(), err)
+	}
+	return review.Response
+}
+
+func TestAdmissionHandlerFor(t *testing.T) {
+	dir := t.TempDir()
+	policies := filepath.Join(dir, "policies.yaml")
+	if err := os.WriteFile(policies, []byte(allowAllPolicy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := shadow.NewEvaluator(policies)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	corpusDir := filepath.Join(dir, "corpus")
+	recorder, err := corpus.NewRecorder(corpusDir, 10)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	auditLog := filepath.Join(dir, "audit.log")
+	auditLogger, err := audit.NewLogger(auditLog, 1, 1)
+	if err != nil {
+		t.Fatalf("failed to create audit logger: %v", err)
+	}
+	opts := HandlerOptions{ShadowEvaluator: evaluator, CorpusRecorder: recorder, AuditLogger: auditLogger}
+
+	config := options.NewConfig()
+	config.ValidationBackends = map[string]string{
+		"queues":    options.ValidationBackendVAP,
+		"podgroups": options.ValidationBackendBoth,
+	}
+
+	testCases := []struct {
+		Name          string
+		Resource      string
+		ExpectAllowed bool
+		ExpectShadow  bool
+		ExpectBackend string
+	}{
+		{
+			Name:          "webhook enforces validation",
+			Resource:      "jobs",
+			ExpectAllowed: false,
+			ExpectShadow:  false,
+			ExpectBackend: options.ValidationBackendWebhook,
+		},
+		{
+			Name:          "validation is delegated to policies",
+			Resource:      "queues",
+			ExpectAllowed: true,
+			ExpectShadow:  false,
+			ExpectBackend: options.ValidationBackendVAP,
+		},
+		{
+			Name:          "webhook enforces validation and policies are evaluated in shadow",
+			Resource:      "podgroups",
+			ExpectAllowed: false,
+			ExpectShadow:  true,
+			ExpectBackend: options.ValidationBackendBoth,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			service := &AdmissionService{
+				Path:             "/" + testCase.Resource + "/validate",
+				Func:             denyAll,
+				ValidatingConfig: &whv1.ValidatingWebhookConfiguration{},
+			}
+			evaluations := shadowEvaluations(t, testCase.Resource)
+
+			response := serve(t, AdmissionHandlerFor(config, service, opts), testCase.Resource)
+			if response.Allowed != testCase.ExpectAllowed {
+				t.Errorf("expected allowed %v, got %v", testCase.ExpectAllowed, response.Allowed)
+			}
+			if shadowed := shadowEvaluations(t, testCase.Resource) > evaluations; shadowed != testCase.ExpectShadow {
+				t.Errorf("expected shadow evaluation %v, got %v", testCase.ExpectShadow, shadowed)
+			}
+			if backend := lastAuditedBackend(t, auditLog); backend != testCase.ExpectBackend {
+				t.Errorf("expected audited backend %s, got %s", testCase.ExpectBackend, backend)
+			}
+		})
+	}
+
+	// the requests are recorded in order, so once the last one is written, the delegated one would have been
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go recorder.Run(stopCh)
+	if err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		_, err := os.Stat(filepath.Join(corpusDir, "podgroups-create-podgroups.yaml"))
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("expected the requests to be recorded: %v", err)
+	}
+	files, err := filepath.Glob(filepath.Join(corpusDir, "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{filepath.Join(corpusDir, "jobs-create-jobs.yaml"), filepath.Join(corpusDir, "podgroups-create-podgroups.yaml")}
+	if len(files) != len(expected) || files[0] != expected[0] || files[1] != expected[1] {
+		t.Errorf("expected recorded requests %v, got %v", expected, files)
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package router
 
 import (
+	"strings"
+
 	admissionv1 "k8s.io/api/admission/v1"
 	whv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/client-go/kubernetes"
@@ -49,3 +51,8 @@ type AdmissionService struct {
 
 	Config *AdmissionServiceConfig
 }
+
+// Resource returns the resource served by the admission service, e.g. "jobs" for "/jobs/validate".
+func (s *AdmissionService) Resource() string {
+	return strings.Split(strings.TrimPrefix(s.Path, "/"), "/")[0]
+}
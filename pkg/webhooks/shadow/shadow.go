@@ -0,0 +1,352 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shadow evaluates ValidatingAdmissionPolicies in-process alongside the validating
+// webhooks, and reports the admission requests on which their verdicts diverge.
+package shadow
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/predicates/rules"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/cel"
+	"volcano.sh/volcano/pkg/webhooks/manifest"
+	"volcano.sh/volcano/pkg/webhooks/metrics"
+)
+
+// AdmitFunc is the admit function of a validating webhook.
+type AdmitFunc = func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse
+
+type policy struct {
+	name             string
+	matchResources   []admissionregistrationv1.NamedRuleWithOperations
+	excludeResources []admissionregistrationv1.NamedRuleWithOperations
+	matchConditions  *cel.Program
+	validations      *cel.Program
+}
+
+// Evaluator evaluates ValidatingAdmissionPolicies in shadow of the validating webhooks.
+//
+// Only the resource rules of matchConstraints and the matchConditions of a policy are honored when
+// matching requests; namespace and object selectors are ignored. Policies using params are skipped.
+// Bindings are ignored, every policy is evaluated as if it was bound to deny all the requests it matches.
+type Evaluator struct {
+	policies []*policy
+	warnings []string
+}
+
+// NewEvaluator loads the ValidatingAdmissionPolicies in the manifests under path, which is either
+// a file or a directory of .yaml, .yml and .json files. Other kinds of objects are ignored.
+func NewEvaluator(path string) (*Evaluator, error) {
+	compiler := cel.NewCompiler()
+	evaluator := &Evaluator{}
+	err := manifest.Load(path, func(object *unstructured.Unstructured) error {
+		switch object.GetKind() {
+		case manifest.KindValidatingAdmissionPolicy:
+			vap := &admissionregistrationv1.ValidatingAdmissionPolicy{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, vap); err != nil {
+				return err
+			}
+			if reason := unsupported(vap); reason != "" {
+				evaluator.warn("policy %s is skipped, %s", vap.Name, reason)
+				return nil
+			}
+			evaluator.warnSelectors("policy", vap.Name, vap.Spec.MatchConstraints)
+			p, err := compilePolicy(compiler, vap)
+			if err != nil {
+				return fmt.Errorf("failed to compile policy %s: %v", vap.Name, err)
+			}
+			evaluator.policies = append(evaluator.policies, p)
+		case manifest.KindValidatingAdmissionPolicyBinding:
+			binding := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, binding); err != nil {
+				return err
+			}
+			if binding.Spec.MatchResources != nil {
+				evaluator.warn("the matchResources of binding %s are not applied", binding.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, warning := range evaluator.warnings {
+		klog.Warningf("Shadow policies from %s: %s", path, warning)
+	}
+	klog.V(3).Infof("Loaded %d shadow policies from %s", len(evaluator.policies), path)
+	return evaluator, nil
+}
+
+// Warnings returns the parts of the loaded manifests which are not honored by the evaluator, so that
+// its verdicts may differ from the ones of the apiserver: the skipped policies, and the selectors and
+// binding constraints which are not applied.
+func (e *Evaluator) Warnings() []string {
+	return e.warnings
+}
+
+func (e *Evaluator) warn(format string, args ...interface{}) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+func (e *Evaluator) warnSelectors(kind, name string, match *admissionregistrationv1.MatchResources) {
+	if match == nil {
+		return
+	}
+	if !emptySelector(match.NamespaceSelector) {
+		e.warn("the namespaceSelector of %s %s is not applied", kind, name)
+	}
+	if !emptySelector(match.ObjectSelector) {
+		e.warn("the objectSelector of %s %s is not applied", kind, name)
+	}
+}
+
+func emptySelector(selector *metav1.LabelSelector) bool {
+	return selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0)
+}
+
+// unsupported returns why the policy cannot be evaluated, or an empty string if it can.
+func unsupported(p *admissionregistrationv1.ValidatingAdmissionPolicy) string {
+	if p.Spec.ParamKind != nil {
+		return "policies with params are not supported"
+	}
+	if p.Spec.MatchConstraints == nil {
+		return "it has no matchConstraints"
+	}
+	return ""
+}
+
+func compilePolicy(compiler *cel.Compiler, p *admissionregistrationv1.ValidatingAdmissionPolicy) (*policy, error) {
+	conditions := make([]cel.Validation, len(p.Spec.MatchConditions))
+	for i, condition := range p.Spec.MatchConditions {
+		conditions[i] = cel.Validation{Name: condition.Name, Expression: condition.Expression}
+	}
+	matchConditions, err := compiler.Compile(conditions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]cel.Variable, len(p.Spec.Variables))
+	for i, variable := range p.Spec.Variables {
+		variables[i] = cel.Variable{Name: variable.Name, Expression: variable.Expression}
+	}
+	validations := make([]cel.Validation, len(p.Spec.Validations))
+	for i, validation := range p.Spec.Validations {
+		validations[i] = cel.Validation{Name: p.Name, Expression: validation.Expression, Message: validation.Message}
+	}
+	program, err := compiler.CompileWithVariables(validations, variables, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy{
+		name:             p.Name,
+		matchResources:   p.Spec.MatchConstraints.ResourceRules,
+		excludeResources: p.Spec.MatchConstraints.ExcludeResourceRules,
+		matchConditions:  matchConditions,
+		validations:      program,
+	}, nil
+}
+
+// Shadow wraps the admit function, evaluating the policies matching each admission request and
+// comparing their verdict with the one of the webhook. The response of the webhook is always returned.
+func (e *Evaluator) Shadow(admit AdmitFunc) AdmitFunc {
+	return func(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+		response := admit(ar)
+		if ar.Request != nil {
+			e.compare(ar.Request, response != nil && response.Allowed)
+		}
+		return response
+	}
+}
+
+func (e *Evaluator) compare(request *admissionv1.AdmissionRequest, webhookAllowed bool) {
+	resource := request.Resource.Resource
+	operation := string(request.Operation)
+
+	matched, denials, err := e.Evaluate(request)
+	if err != nil {
+		klog.Errorf("Failed to evaluate shadow policies for %s %s/%s: %v", resource, request.Namespace, request.Name, err)
+		metrics.UpdateShadowEvaluation(resource, operation, metrics.ShadowResultError)
+		return
+	}
+	if !matched {
+		return
+	}
+
+	policiesAllowed := len(denials) == 0
+	if policiesAllowed != webhookAllowed {
+		klog.Warningf("Shadow policies diverge from webhook on %s of %s %s/%s, webhook allowed: %v, policies allowed: %v, denials: %v",
+			operation, resource, request.Namespace, request.Name, webhookAllowed, policiesAllowed, denials)
+		metrics.UpdateShadowEvaluation(resource, operation, metrics.ShadowResultDivergence)
+		return
+	}
+	metrics.UpdateShadowEvaluation(resource, operation, metrics.ShadowResultMatch)
+}
+
+// Evaluate evaluates the policies matching the admission request. It returns whether any policy
+// matched the request, and the messages of the validations denying it.
+func (e *Evaluator) Evaluate(request *admissionv1.AdmissionRequest) (bool, []string, error) {
+	var matched bool
+	var denials []string
+	for _, p := range e.policies {
+		if !p.matches(request) {
+			continue
+		}
+		applies, err := p.evaluateConditions(request)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed to evaluate matchConditions of policy %s: %v", p.name, err)
+		}
+		if !applies {
+			continue
+		}
+
+		matched = true
+		messages, err := p.evaluateValidations(request)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed to evaluate policy %s: %v", p.name, err)
+		}
+		for _, message := range messages {
+			denials = append(denials, fmt.Sprintf("%s: %s", p.name, message))
+		}
+	}
+	return matched, denials, nil
+}
+
+// Verdict is the verdict of a policy on an admission request.
+type Verdict struct {
+	Policy  string   `json:"policy"`
+	Allowed bool     `json:"allowed"`
+	Denials []string `json:"denials,omitempty"`
+	// Error is the error evaluating the policy, which denies the request.
+	Error string `json:"error,omitempty"`
+}
+
+// Verdicts returns the verdict of every policy matching the admission request, in the order the
+// policies were loaded. Unlike Evaluate, an error evaluating a policy is reported in its verdict.
+func (e *Evaluator) Verdicts(request *admissionv1.AdmissionRequest) []Verdict {
+	var verdicts []Verdict
+	for _, p := range e.policies {
+		if !p.matches(request) {
+			continue
+		}
+		applies, err := p.evaluateConditions(request)
+		if err != nil {
+			verdicts = append(verdicts, Verdict{Policy: p.name, Error: fmt.Sprintf("failed to evaluate matchConditions: %v", err)})
+			continue
+		}
+		if !applies {
+			continue
+		}
+
+		messages, err := p.evaluateValidations(request)
+		if err != nil {
+			verdicts = append(verdicts, Verdict{Policy: p.name, Error: err.Error()})
+			continue
+		}
+		verdicts = append(verdicts, Verdict{Policy: p.name, Allowed: len(messages) == 0, Denials: messages})
+	}
+	return verdicts
+}
+
+func (p *policy) matches(request *admissionv1.AdmissionRequest) bool {
+	for _, rule := range p.excludeResources {
+		if ruleMatches(rule, request) {
+			return false
+		}
+	}
+	for _, rule := range p.matchResources {
+		if ruleMatches(rule, request) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *policy) evaluateConditions(request *admissionv1.AdmissionRequest) (bool, error) {
+	results, err := p.matchConditions.Evaluate(context.TODO(), request, nil)
+	if err != nil {
+		return false, err
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			return false, result.Err
+		}
+		if !result.Allowed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// evaluateValidations returns the messages of the validations denying the request.
+func (p *policy) evaluateValidations(request *admissionv1.AdmissionRequest) ([]string, error) {
+	results, err := p.validations.Evaluate(context.TODO(), request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, result := range results {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		if result.Allowed {
+			continue
+		}
+		message := result.Validation.Message
+		if message == "" {
+			message = fmt.Sprintf("failed expression: %s", result.Validation.Expression)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// ruleMatches matches the request with the rule as the apiserver does, the resource names of the rule
+// are honored as well.
+func ruleMatches(rule admissionregistrationv1.NamedRuleWithOperations, request *admissionv1.AdmissionRequest) bool {
+	if len(rule.ResourceNames) != 0 && !contains(rule.ResourceNames, request.Name) {
+		return false
+	}
+
+	kind := schema.GroupVersionKind{Group: request.Kind.Group, Version: request.Kind.Version, Kind: request.Kind.Kind}
+	resource := schema.GroupVersionResource{Group: request.Resource.Group, Version: request.Resource.Version, Resource: request.Resource.Resource}
+	attr := admission.NewAttributesRecord(nil, nil, kind, request.Namespace, request.Name, resource,
+		request.SubResource, admission.Operation(request.Operation), nil, false, nil)
+	matcher := &rules.Matcher{Rule: rule.RuleWithOperations, Attr: attr}
+	return matcher.Matches()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shadow
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const manifests = `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-min-available
+spec:
+  failurePolicy: Fail
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "object.spec.minAvailable >= 0"
+    message: "job 'minAvailable' must be >= 0"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: job-min-available
+spec:
+  policyName: job-min-available
+  validationActions: ["Deny"]
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-max-retry
+spec:
+  paramKind:
+    apiVersion: v1
+    kind: ConfigMap
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "object.spec.maxRetry <= int(params.data.maxRetry)"
+`
+
+func jobRequest(operation admissionv1.Operation, minAvailable int) *admissionv1.AdmissionRequest {
+	return &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: "batch.volcano.sh", Version: "v1alpha1", Kind: "Job"},
+		Resource:  metav1.GroupVersionResource{Group: "batch.volcano.sh", Version: "v1alpha1", Resource: "jobs"},
+		Name:      "job",
+		Namespace: "default",
+		Operation: operation,
+		Object: runtime.RawExtension{
+			Raw: []byte(fmt.Sprintf(`{"apiVersion":"batch.volcano.sh/v1alpha1","kind":"Job","metadata":{"name":"job","namespace":"default"},"spec":{"minAvailable":%d}}`, minAvailable)),
+		},
+	}
+}
+
+func TestNewEvaluator(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "policies.yaml"), []byte(manifests), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	evaluator, err := NewEvaluator(dir)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	// the binding is ignored and the policy with params is skipped
+	if len(evaluator.policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(evaluator.policies))
+	}
+	expectedWarnings := []string{"policy job-max-retry is skipped, policies with params are not supported"}
+	if !reflect.DeepEqual(evaluator.Warnings(), expectedWarnings) {
+		t.Errorf("expected warnings %v, got %v", expectedWarnings, evaluator.Warnings())
+	}
+
+	selectorsFile := filepath.Join(dir, "selectors.yaml")
+	selectors := `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: labeled-jobs
+spec:
+  matchConstraints:
+    namespaceSelector: {}
+    objectSelector:
+      matchLabels:
+        team: a
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "true"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: labeled-jobs
+spec:
+  policyName: labeled-jobs
+  validationActions: ["Deny"]
+  matchResources:
+    namespaceSelector:
+      matchLabels:
+        env: prod
+`
+	if err := os.WriteFile(selectorsFile, []byte(selectors), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err = NewEvaluator(selectorsFile)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	// the empty namespace selector matches all namespaces
+	expectedWarnings = []string{
+		"the objectSelector of policy labeled-jobs is not applied",
+		"the matchResources of binding labeled-jobs are not applied",
+	}
+	if !reflect.DeepEqual(evaluator.Warnings(), expectedWarnings) {
+		t.Errorf("expected warnings %v, got %v", expectedWarnings, evaluator.Warnings())
+	}
+
+	brokenFile := filepath.Join(dir, "broken.yaml")
+	broken := `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: broken
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["*"]
+      apiVersions: ["*"]
+      operations: ["*"]
+      resources: ["*"]
+  validations:
+  - expression: "object.spec.minAvailable >="
+`
+	if err := os.WriteFile(brokenFile, []byte(broken), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewEvaluator(brokenFile); err == nil {
+		t.Errorf("expected error for policy with invalid expression")
+	}
+}
+
+func TestShadow(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	if err := os.WriteFile(file, []byte(manifests), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := NewEvaluator(file)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Request       *admissionv1.AdmissionRequest
+		ExpectMatched bool
+		ExpectDenials int
+	}{
+		{
+			Name:          "valid job is allowed",
+			Request:       jobRequest(admissionv1.Create, 1),
+			ExpectMatched: true,
+			ExpectDenials: 0,
+		},
+		{
+			Name:          "invalid job is denied",
+			Request:       jobRequest(admissionv1.Update, -1),
+			ExpectMatched: true,
+			ExpectDenials: 1,
+		},
+		{
+			Name:          "delete is not matched",
+			Request:       jobRequest(admissionv1.Delete, -1),
+			ExpectMatched: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			matched, denials, err := evaluator.Evaluate(testCase.Request)
+			if err != nil {
+				t.Fatalf("failed to evaluate: %v", err)
+			}
+			if matched != testCase.ExpectMatched {
+				t.Errorf("expected matched %v, got %v", testCase.ExpectMatched, matched)
+			}
+			if len(denials) != testCase.ExpectDenials {
+				t.Errorf("expected %d denials, got %v", testCase.ExpectDenials, denials)
+			}
+
+			// the response of the webhook is returned regardless of the shadow verdict
+			expected := &admissionv1.AdmissionResponse{Allowed: true}
+			admit := evaluator.Shadow(func(admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+				return expected
+			})
+			if response := admit(admissionv1.AdmissionReview{Request: testCase.Request}); response != expected {
+				t.Errorf("expected webhook response %v, got %v", expected, response)
+			}
+		})
+	}
+}
+
+func TestVerdicts(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	queuePolicy := `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-queue
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE"]
+      resources: ["jobs"]
+  validations:
+  - expression: "object.spec.queue != ''"
+`
+	if err := os.WriteFile(file, []byte(manifests+queuePolicy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := NewEvaluator(file)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+
+	testCases := []struct {
+		Name           string
+		Request        *admissionv1.AdmissionRequest
+		ExpectVerdicts []Verdict
+	}{
+		{
+			Name:    "job without queue reports the evaluation error",
+			Request: jobRequest(admissionv1.Create, -1),
+			ExpectVerdicts: []Verdict{
+				{Policy: "job-min-available", Allowed: false, Denials: []string{"job 'minAvailable' must be >= 0"}},
+				{Policy: "job-queue", Allowed: false, Error: "expression 'object.spec.queue != ''' resulted in error: no such key: queue"},
+			},
+		},
+		{
+			Name:    "only matched policies have a verdict",
+			Request: jobRequest(admissionv1.Update, 1),
+			ExpectVerdicts: []Verdict{
+				{Policy: "job-min-available", Allowed: true},
+			},
+		},
+		{
+			Name:    "no policy is matched",
+			Request: jobRequest(admissionv1.Delete, 1),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			verdicts := evaluator.Verdicts(testCase.Request)
+			if !reflect.DeepEqual(verdicts, testCase.ExpectVerdicts) {
+				t.Errorf("expected verdicts %+v, got %+v", testCase.ExpectVerdicts, verdicts)
+			}
+		})
+	}
+}
+
+func TestVariables(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.yaml")
+	policy := `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: job-min-available
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["batch.volcano.sh"]
+      apiVersions: ["v1alpha1"]
+      operations: ["CREATE"]
+      resources: ["jobs"]
+  variables:
+  - name: minAvailable
+    expression: "object.spec.minAvailable"
+  - name: valid
+    expression: "variables.minAvailable >= 0"
+  validations:
+  - expression: "variables.valid"
+    message: "job 'minAvailable' must be >= 0"
+`
+	if err := os.WriteFile(file, []byte(policy), 0644); err != nil {
+		t.Fatal(err)
+	}
+	evaluator, err := NewEvaluator(file)
+	if err != nil {
+		t.Fatalf("failed to create evaluator: %v", err)
+	}
+	if len(evaluator.Warnings()) != 0 {
+		t.Errorf("expected no warnings, got %v", evaluator.Warnings())
+	}
+
+	expected := []Verdict{{Policy: "job-min-available", Allowed: false, Denials: []string{"job 'minAvailable' must be >= 0"}}}
+	if verdicts := evaluator.Verdicts(jobRequest(admissionv1.Create, -1)); !reflect.DeepEqual(verdicts, expected) {
+		t.Errorf("expected verdicts %+v, got %+v", expected, verdicts)
+	}
+	expected = []Verdict{{Policy: "job-min-available", Allowed: true}}
+	if verdicts := evaluator.Verdicts(jobRequest(admissionv1.Create, 1)); !reflect.DeepEqual(verdicts, expected) {
+		t.Errorf("expected verdicts %+v, got %+v", expected, verdicts)
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Resources   []string
+		Resource    string
+		SubResource string
+		Expect      bool
+	}{
+		{Name: "exact resource", Resources: []string{"jobs"}, Resource: "jobs", Expect: true},
+		{Name: "other resource", Resources: []string{"queues"}, Resource: "jobs", Expect: false},
+		{Name: "wildcard matches resource", Resources: []string{"*"}, Resource: "jobs", Expect: true},
+		{Name: "wildcard does not match subresource", Resources: []string{"*"}, Resource: "jobs", SubResource: "status", Expect: false},
+		{Name: "resource does not match its subresource", Resources: []string{"jobs"}, Resource: "jobs", SubResource: "status", Expect: false},
+		{Name: "exact subresource", Resources: []string{"jobs/status"}, Resource: "jobs", SubResource: "status", Expect: true},
+		{Name: "all resources and subresources match subresource", Resources: []string{"*/*"}, Resource: "jobs", SubResource: "status", Expect: true},
+		{Name: "all resources and subresources match resource", Resources: []string{"*/*"}, Resource: "jobs", Expect: true},
+		{Name: "subresource of all resources", Resources: []string{"*/status"}, Resource: "queues", SubResource: "status", Expect: true},
+		{Name: "subresource of all resources does not match resource", Resources: []string{"*/status"}, Resource: "queues", Expect: false},
+		{Name: "other subresource of all resources", Resources: []string{"*/scale"}, Resource: "queues", SubResource: "status", Expect: false},
+		{Name: "all subresources of resource match subresource", Resources: []string{"jobs/*"}, Resource: "jobs", SubResource: "status", Expect: true},
+		{Name: "all subresources of resource match resource", Resources: []string{"jobs/*"}, Resource: "jobs", Expect: true},
+		{Name: "all subresources of other resource", Resources: []string{"queues/*"}, Resource: "jobs", SubResource: "status", Expect: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rule := admissionregistrationv1.NamedRuleWithOperations{
+				RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.OperationAll},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{"*"},
+						APIVersions: []string{"*"},
+						Resources:   testCase.Resources,
+					},
+				},
+			}
+			request := jobRequest(admissionv1.Update, 1)
+			request.Resource.Resource = testCase.Resource
+			request.SubResource = testCase.SubResource
+			if matched := ruleMatches(rule, request); matched != testCase.Expect {
+				t.Errorf("expected %v to match %s/%s: %v, got %v", testCase.Resources, testCase.Resource, testCase.SubResource, testCase.Expect, matched)
+			}
+		})
+	}
+}